@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
@@ -165,6 +166,18 @@ func resourceApiManagementService() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"nat_gateway_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"min_api_version": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -552,6 +565,14 @@ func resourceApiManagementService() *pluginsdk.Resource {
 				},
 			},
 
+			"outbound_public_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"portal_url": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -612,6 +633,20 @@ func resourceApiManagementService() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("virtual_network_configuration", func(ctx context.Context, old, new, meta interface{}) bool {
 				return !(len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0)
 			}),
+
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// the API rejects disabling Public Network Access when the service is being created
+				if d.Id() == "" && !d.Get("public_network_access_enabled").(bool) {
+					return fmt.Errorf("`public_network_access_enabled` can only be set to `false` when updating an existing API Management Service")
+				}
+
+				// the NAT Gateway is only available for services on the `stv2` platform which are injected into a Virtual Network
+				if d.Get("nat_gateway_enabled").(bool) && d.Get("virtual_network_type").(string) == string(apimanagement.VirtualNetworkTypeNone) {
+					return fmt.Errorf("`nat_gateway_enabled` can only be set to `true` when `virtual_network_type` is set to `External` or `Internal`")
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -628,6 +663,8 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 	id := parse.NewApiManagementID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
+	publicNetworkAccessEnabled := d.Get("public_network_access_enabled").(bool)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName)
 		if err != nil {
@@ -649,7 +686,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	notificationSenderEmail := d.Get("notification_sender_email").(string)
 	virtualNetworkType := d.Get("virtual_network_type").(string)
 
-	customProperties, err := expandApiManagementCustomProperties(d, sku.Name == apimanagement.SkuTypeConsumption)
+	customProperties, err := expandApiManagementCustomProperties(d, sku.Name)
 	if err != nil {
 		return err
 	}
@@ -680,6 +717,10 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	properties.Identity = identity
 
 	if _, ok := d.GetOk("additional_location"); ok {
+		if apiManagementSkuIsV2(sku.Name) {
+			return fmt.Errorf("`additional_location` is not supported when sku type is `BasicV2` or `StandardV2`")
+		}
+
 		var err error
 		properties.ServiceProperties.AdditionalLocations, err = expandAzureRmApiManagementAdditionalLocations(d, sku)
 		if err != nil {
@@ -691,6 +732,11 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 		properties.ServiceProperties.NotificationSenderEmail = &notificationSenderEmail
 	}
 
+	// the v2 SKUs use Virtual Network Integration rather than Virtual Network Injection, which isn't supported by this resource
+	if apiManagementSkuIsV2(sku.Name) && virtualNetworkType != string(apimanagement.VirtualNetworkTypeNone) {
+		return fmt.Errorf("`virtual_network_type` must be set to `None` when sku type is `BasicV2` or `StandardV2`")
+	}
+
 	if virtualNetworkType != "" {
 		properties.ServiceProperties.VirtualNetworkType = apimanagement.VirtualNetworkType(virtualNetworkType)
 
@@ -730,22 +776,80 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 		properties.Zones = azure.ExpandZones(v)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, properties)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	natGatewayEnabled := d.Get("nat_gateway_enabled").(bool)
+
+	extendedClient := meta.(*clients.Client).ApiManagement.ServiceExtendedClient
+
+	// the 2020-12-01 API Version predates the v2 SKUs, so these are provisioned using the newer API Version instead
+	if apiManagementSkuIsV2(sku.Name) {
+		parameters := azuresdkhacks.ServiceResource{
+			ServiceResource: properties,
+		}
+		future, err := extendedClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, parameters)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, extendedClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, properties)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	// the Public Network Access and the NAT Gateway State are patched on their own, and only when they've been set or
+	// changed, rather than sending the whole service using the newer API Version
+	updateProperties := azuresdkhacks.ServiceUpdateProperties{}
+	updateRequired := false
+
+	// Public Network Access can't be disabled during creation (see above) and is enabled by default
+	if !d.IsNewResource() && d.HasChange("public_network_access_enabled") {
+		publicNetworkAccess := azuresdkhacks.PublicNetworkAccessEnabled
+		if !publicNetworkAccessEnabled {
+			publicNetworkAccess = azuresdkhacks.PublicNetworkAccessDisabled
+		}
+		updateProperties.PublicNetworkAccess = utils.String(publicNetworkAccess)
+		updateRequired = true
+	}
+
+	// the NAT Gateway is disabled by default, so there's nothing to send for a new service unless it's being enabled
+	if (d.IsNewResource() && natGatewayEnabled) || (!d.IsNewResource() && d.HasChange("nat_gateway_enabled")) {
+		natGatewayState := azuresdkhacks.NatGatewayStateDisabled
+		if natGatewayEnabled {
+			natGatewayState = azuresdkhacks.NatGatewayStateEnabled
+		}
+		updateProperties.NatGatewayState = utils.String(natGatewayState)
+		updateRequired = true
+	}
+
+	if updateRequired {
+		parameters := azuresdkhacks.ServiceUpdateParameters{
+			Properties: &updateProperties,
+		}
+		future, err := extendedClient.Update(ctx, id.ResourceGroup, id.ServiceName, parameters)
+		if err != nil {
+			return fmt.Errorf("updating the Public Network Access/NAT Gateway State for %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, extendedClient.Client); err != nil {
+			return fmt.Errorf("waiting for update of the Public Network Access/NAT Gateway State for %s: %+v", id, err)
+		}
 	}
 
 	d.SetId(id.ID())
 
 	signInSettingsRaw := d.Get("sign_in").([]interface{})
-	if sku.Name == apimanagement.SkuTypeConsumption && len(signInSettingsRaw) > 0 {
-		return fmt.Errorf("`sign_in` is not support for sku tier `Consumption`")
+	if !apiManagementSkuSupportsPortalSettings(sku.Name) && len(signInSettingsRaw) > 0 {
+		return fmt.Errorf("`sign_in` is not supported for sku tier `%s`", string(sku.Name))
 	}
-	if sku.Name != apimanagement.SkuTypeConsumption {
+	if apiManagementSkuSupportsPortalSettings(sku.Name) {
 		signInSettings := expandApiManagementSignInSettings(signInSettingsRaw)
 		signInClient := meta.(*clients.Client).ApiManagement.SignInClient
 		if _, err := signInClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, signInSettings, ""); err != nil {
@@ -754,10 +858,10 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	signUpSettingsRaw := d.Get("sign_up").([]interface{})
-	if sku.Name == apimanagement.SkuTypeConsumption && len(signInSettingsRaw) > 0 {
-		return fmt.Errorf("`sign_up` is not support for sku tier `Consumption`")
+	if !apiManagementSkuSupportsPortalSettings(sku.Name) && len(signUpSettingsRaw) > 0 {
+		return fmt.Errorf("`sign_up` is not supported for sku tier `%s`", string(sku.Name))
 	}
-	if sku.Name != apimanagement.SkuTypeConsumption {
+	if apiManagementSkuSupportsPortalSettings(sku.Name) {
 		signUpSettings := expandApiManagementSignUpSettings(signUpSettingsRaw)
 		signUpClient := meta.(*clients.Client).ApiManagement.SignUpClient
 		if _, err := signUpClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, signUpSettings, ""); err != nil {
@@ -789,10 +893,10 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	tenantAccessRaw := d.Get("tenant_access").([]interface{})
-	if sku.Name == apimanagement.SkuTypeConsumption && len(tenantAccessRaw) > 0 {
-		return fmt.Errorf("`tenant_access` is not supported for sku tier `Consumption`")
+	if !apiManagementSkuSupportsTenantAccess(sku.Name) && len(tenantAccessRaw) > 0 {
+		return fmt.Errorf("`tenant_access` is not supported for sku tier `%s`", string(sku.Name))
 	}
-	if sku.Name != apimanagement.SkuTypeConsumption && d.HasChange("tenant_access") {
+	if apiManagementSkuSupportsTenantAccess(sku.Name) && d.HasChange("tenant_access") {
 		tenantAccessInformationParametersRaw := d.Get("tenant_access").([]interface{})
 		tenantAccessInformationParameters := expandApiManagementTenantAccessSettings(tenantAccessInformationParametersRaw)
 		tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
@@ -806,6 +910,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	extendedClient := meta.(*clients.Client).ApiManagement.ServiceExtendedClient
 	signInClient := meta.(*clients.Client).ApiManagement.SignInClient
 	signUpClient := meta.(*clients.Client).ApiManagement.SignUpClient
 	tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
@@ -829,6 +934,16 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("making Read request on %s: %+v", *id, err)
 	}
 
+	// the Public Network Access, NAT Gateway State and Outbound Public IP Addresses aren't exposed by the 2020-12-01 SDK,
+	// so these are only retrieved using the newer API Version for the v2 SKUs or when these have been configured
+	var extended azuresdkhacks.ServiceResource
+	if (resp.Sku != nil && apiManagementSkuIsV2(resp.Sku.Name)) || !d.Get("public_network_access_enabled").(bool) || d.Get("nat_gateway_enabled").(bool) {
+		extended, err = extendedClient.Get(ctx, id.ResourceGroup, id.ServiceName)
+		if err != nil {
+			return fmt.Errorf("retrieving the Public Network Access/NAT Gateway State for %s: %+v", *id, err)
+		}
+	}
+
 	policyClient := meta.(*clients.Client).ApiManagement.PolicyClient
 	policy, err := policyClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.PolicyExportFormatXML)
 	if err != nil {
@@ -868,10 +983,23 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("client_certificate_enabled", props.EnableClientCertificate)
 		d.Set("gateway_disabled", props.DisableGateway)
 
+		publicNetworkAccessEnabled := true
+		if extended.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*extended.PublicNetworkAccess, azuresdkhacks.PublicNetworkAccessEnabled)
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		natGatewayEnabled := false
+		if extended.NatGatewayState != nil {
+			natGatewayEnabled = strings.EqualFold(*extended.NatGatewayState, azuresdkhacks.NatGatewayStateEnabled)
+		}
+		d.Set("nat_gateway_enabled", natGatewayEnabled)
+		d.Set("outbound_public_ip_addresses", utils.FlattenStringSlice(extended.OutboundPublicIPAddresses))
+
 		d.Set("certificate", flattenAPIManagementCertificates(d, props.Certificates))
 
 		if resp.Sku != nil && resp.Sku.Name != "" {
-			if err := d.Set("security", flattenApiManagementSecurityCustomProperties(props.CustomProperties, resp.Sku.Name == apimanagement.SkuTypeConsumption)); err != nil {
+			if err := d.Set("security", flattenApiManagementSecurityCustomProperties(props.CustomProperties, resp.Sku.Name)); err != nil {
 				return fmt.Errorf("setting `security`: %+v", err)
 			}
 		}
@@ -911,7 +1039,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 
 	d.Set("zones", azure.FlattenZones(resp.Zones))
 
-	if resp.Sku.Name != apimanagement.SkuTypeConsumption {
+	if resp.Sku != nil && apiManagementSkuSupportsPortalSettings(resp.Sku.Name) {
 		signInSettings, err := signInClient.Get(ctx, id.ResourceGroup, id.ServiceName)
 		if err != nil {
 			return fmt.Errorf("retrieving Sign In Settings for %s: %+v", *id, err)
@@ -933,7 +1061,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("sign_up", []interface{}{})
 	}
 
-	if resp.Sku.Name != apimanagement.SkuTypeConsumption {
+	if apiManagementSkuSupportsTenantAccess(resp.Sku.Name) {
 		tenantAccessInformationContract, err := tenantAccessClient.ListSecrets(ctx, id.ResourceGroup, id.ServiceName, "access")
 		if err != nil {
 			return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
@@ -1391,6 +1519,26 @@ func expandAzureRmApiManagementSkuName(d *pluginsdk.ResourceData) *apimanagement
 	}
 }
 
+func apiManagementSkuIsV2(input apimanagement.SkuType) bool {
+	return input == azuresdkhacks.SkuTypeBasicV2 || input == azuresdkhacks.SkuTypeStandardV2
+}
+
+// the Direct Management API (and therefore Tenant Access) isn't available for the Consumption or the v2 SKUs
+func apiManagementSkuSupportsTenantAccess(input apimanagement.SkuType) bool {
+	return input != apimanagement.SkuTypeConsumption && !apiManagementSkuIsV2(input)
+}
+
+// the Sign In and Sign Up settings of the Developer Portal aren't available for the Consumption or the v2 SKUs
+func apiManagementSkuSupportsPortalSettings(input apimanagement.SkuType) bool {
+	return input != apimanagement.SkuTypeConsumption && !apiManagementSkuIsV2(input)
+}
+
+// the Consumption and the v2 SKUs only support configuring the Backend and the TLS 1.0/1.1 Frontend Protocols, rather
+// than SSL 3.0 on the Frontend or the individual Ciphers
+func apiManagementSkuSupportsCipherConfiguration(input apimanagement.SkuType) bool {
+	return input != apimanagement.SkuTypeConsumption && !apiManagementSkuIsV2(input)
+}
+
 func flattenApiManagementServiceSkuName(input *apimanagement.ServiceSkuProperties) string {
 	if input == nil {
 		return ""
@@ -1399,7 +1547,9 @@ func flattenApiManagementServiceSkuName(input *apimanagement.ServiceSkuPropertie
 	return fmt.Sprintf("%s_%d", string(input.Name), *input.Capacity)
 }
 
-func expandApiManagementCustomProperties(d *pluginsdk.ResourceData, skuIsConsumption bool) (map[string]*string, error) {
+func expandApiManagementCustomProperties(d *pluginsdk.ResourceData, skuName apimanagement.SkuType) (map[string]*string, error) {
+	cipherConfigurationSupported := apiManagementSkuSupportsCipherConfiguration(skuName)
+
 	backendProtocolSsl3 := false
 	backendProtocolTls10 := false
 	backendProtocolTls11 := false
@@ -1444,48 +1594,48 @@ func expandApiManagementCustomProperties(d *pluginsdk.ResourceData, skuIsConsump
 		tlsRsaWithAes256CbcShaCiphers = v["tls_rsa_with_aes256_cbc_sha_ciphers_enabled"].(bool)
 		tlsRsaWithAes128CbcShaCiphers = v["tls_rsa_with_aes128_cbc_sha_ciphers_enabled"].(bool)
 
-		if skuIsConsumption && frontendProtocolSsl3 {
-			return nil, fmt.Errorf("`enable_frontend_ssl30` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && frontendProtocolSsl3 {
+			return nil, fmt.Errorf("`enable_frontend_ssl30` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tripleDesCiphers {
-			return nil, fmt.Errorf("`enable_triple_des_ciphers` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tripleDesCiphers {
+			return nil, fmt.Errorf("`enable_triple_des_ciphers` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsEcdheEcdsaWithAes256CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_ecdhe_ecdsa_with_aes256_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsEcdheEcdsaWithAes256CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_ecdhe_ecdsa_with_aes256_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsEcdheEcdsaWithAes128CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_ecdhe_ecdsa_with_aes128_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsEcdheEcdsaWithAes128CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_ecdhe_ecdsa_with_aes128_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsEcdheRsaWithAes256CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_ecdhe_rsa_with_aes256_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsEcdheRsaWithAes256CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_ecdhe_rsa_with_aes256_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsEcdheRsaWithAes128CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_ecdhe_rsa_with_aes128_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsEcdheRsaWithAes128CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_ecdhe_rsa_with_aes128_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsRsaWithAes128GcmSha256Ciphers {
-			return nil, fmt.Errorf("`tls_rsa_with_aes128_gcm_sha256_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsRsaWithAes128GcmSha256Ciphers {
+			return nil, fmt.Errorf("`tls_rsa_with_aes128_gcm_sha256_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsRsaWithAes256CbcSha256Ciphers {
-			return nil, fmt.Errorf("`tls_rsa_with_aes256_cbc_sha256_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsRsaWithAes256CbcSha256Ciphers {
+			return nil, fmt.Errorf("`tls_rsa_with_aes256_cbc_sha256_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsRsaWithAes128CbcSha256Ciphers {
-			return nil, fmt.Errorf("`tls_rsa_with_aes128_cbc_sha256_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsRsaWithAes128CbcSha256Ciphers {
+			return nil, fmt.Errorf("`tls_rsa_with_aes128_cbc_sha256_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsRsaWithAes256CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_rsa_with_aes256_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsRsaWithAes256CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_rsa_with_aes256_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 
-		if skuIsConsumption && tlsRsaWithAes128CbcShaCiphers {
-			return nil, fmt.Errorf("`tls_rsa_with_aes128_cbc_sha_ciphers_enabled` is not support for Sku Tier `Consumption`")
+		if !cipherConfigurationSupported && tlsRsaWithAes128CbcShaCiphers {
+			return nil, fmt.Errorf("`tls_rsa_with_aes128_cbc_sha_ciphers_enabled` is not supported for Sku Tier `%s`", string(skuName))
 		}
 	}
 
@@ -1497,7 +1647,7 @@ func expandApiManagementCustomProperties(d *pluginsdk.ResourceData, skuIsConsump
 		apimFrontendProtocolTls11: utils.String(strconv.FormatBool(frontendProtocolTls11)),
 	}
 
-	if !skuIsConsumption {
+	if cipherConfigurationSupported {
 		customProperties[apimFrontendProtocolSsl3] = utils.String(strconv.FormatBool(frontendProtocolSsl3))
 		customProperties[apimTripleDesCiphers] = utils.String(strconv.FormatBool(tripleDesCiphers))
 		customProperties[apimTlsEcdheEcdsaWithAes256CbcShaCiphers] = utils.String(strconv.FormatBool(tlsEcdheEcdsaWithAes256CbcShaCiphers))
@@ -1534,7 +1684,7 @@ func expandAzureRmApiManagementVirtualNetworkConfigurations(d *pluginsdk.Resourc
 	}
 }
 
-func flattenApiManagementSecurityCustomProperties(input map[string]*string, skuIsConsumption bool) []interface{} {
+func flattenApiManagementSecurityCustomProperties(input map[string]*string, skuName apimanagement.SkuType) []interface{} {
 	output := make(map[string]interface{})
 
 	output["enable_backend_ssl30"] = parseApiManagementNilableDictionary(input, apimBackendProtocolSsl3)
@@ -1543,7 +1693,7 @@ func flattenApiManagementSecurityCustomProperties(input map[string]*string, skuI
 	output["enable_frontend_tls10"] = parseApiManagementNilableDictionary(input, apimFrontendProtocolTls10)
 	output["enable_frontend_tls11"] = parseApiManagementNilableDictionary(input, apimFrontendProtocolTls11)
 
-	if !skuIsConsumption {
+	if apiManagementSkuSupportsCipherConfiguration(skuName) {
 		output["enable_frontend_ssl30"] = parseApiManagementNilableDictionary(input, apimFrontendProtocolSsl3)
 		output["triple_des_ciphers_enabled"] = parseApiManagementNilableDictionary(input, apimTripleDesCiphers)
 		output["enable_triple_des_ciphers"] = output["triple_des_ciphers_enabled"] // TODO: remove in v3.0
@@ -0,0 +1,181 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: the 2020-12-01 SDK doesn't support toggling the Public Network Access or the NAT Gateway of an API Management
+// Service, nor does it expose the Outbound Public IP Addresses or support the v2 SKUs (`BasicV2` and `StandardV2`).
+// As such this retrieves the additional fields and patches only those fields, and provisions services using the v2 SKUs
+// (all other SKUs continue to use the SDK) - and can be removed once the SDK is updated.
+
+// 2024-05-01 is pinned since it's the first GA API Version which supports all of `publicNetworkAccess`, `natGatewayState`
+// and the v2 SKUs - which avoids taking a dependency on a Preview API Version
+const apiVersion = "2024-05-01"
+
+const (
+	PublicNetworkAccessEnabled  = "Enabled"
+	PublicNetworkAccessDisabled = "Disabled"
+
+	NatGatewayStateEnabled  = "Enabled"
+	NatGatewayStateDisabled = "Disabled"
+
+	SkuTypeBasicV2    apimanagement.SkuType = "BasicV2"
+	SkuTypeStandardV2 apimanagement.SkuType = "StandardV2"
+)
+
+// ServiceResource wraps apimanagement.ServiceResource, adding the (read-only) Public Network Access, NAT Gateway State
+// and Outbound Public IP Addresses - the Public Network Access and NAT Gateway State are updated using ServiceClient.Update
+type ServiceResource struct {
+	apimanagement.ServiceResource
+	PublicNetworkAccess       *string
+	NatGatewayState           *string
+	OutboundPublicIPAddresses *[]string
+}
+
+func (s *ServiceResource) UnmarshalJSON(body []byte) error {
+	if err := json.Unmarshal(body, &s.ServiceResource); err != nil {
+		return err
+	}
+
+	var extensions serviceResourceExtensions
+	if err := json.Unmarshal(body, &extensions); err != nil {
+		return err
+	}
+
+	if props := extensions.Properties; props != nil {
+		s.PublicNetworkAccess = props.PublicNetworkAccess
+		s.NatGatewayState = props.NatGatewayState
+		s.OutboundPublicIPAddresses = props.OutboundPublicIPAddresses
+	}
+	return nil
+}
+
+type serviceResourceExtensions struct {
+	Properties *struct {
+		PublicNetworkAccess       *string   `json:"publicNetworkAccess,omitempty"`
+		NatGatewayState           *string   `json:"natGatewayState,omitempty"`
+		OutboundPublicIPAddresses *[]string `json:"outboundPublicIPAddresses,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// ServiceUpdateParameters contains the subset of the API Management Service which can be updated using
+// ServiceClient.Update, so that no other part of the service is sent using the newer API Version
+type ServiceUpdateParameters struct {
+	Properties *ServiceUpdateProperties `json:"properties,omitempty"`
+}
+
+type ServiceUpdateProperties struct {
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+	NatGatewayState     *string `json:"natGatewayState,omitempty"`
+}
+
+type ServiceClient struct {
+	apimanagement.BaseClient
+}
+
+func NewServiceClientWithBaseURI(baseURI string, subscriptionID string) ServiceClient {
+	return ServiceClient{apimanagement.NewWithBaseURI(baseURI, subscriptionID)}
+}
+
+func (client ServiceClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serviceName string, parameters ServiceResource) (future azure.Future, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(servicePath, servicePathParameters(client.SubscriptionID, resourceGroupName, serviceName)),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	future, err = azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return future, nil
+}
+
+// Update patches only the fields specified in parameters, which is a long running operation
+func (client ServiceClient) Update(ctx context.Context, resourceGroupName string, serviceName string, parameters ServiceUpdateParameters) (future azure.Future, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(servicePath, servicePathParameters(client.SubscriptionID, resourceGroupName, serviceName)),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Update", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Update", resp, "Failure sending request")
+	}
+
+	future, err = azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Update", resp, "Failure responding to request")
+	}
+
+	return future, nil
+}
+
+func (client ServiceClient) Get(ctx context.Context, resourceGroupName string, serviceName string) (result ServiceResource, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(servicePath, servicePathParameters(client.SubscriptionID, resourceGroupName, serviceName)),
+		autorest.WithQueryParameters(queryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "apimanagement.ServiceClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+const servicePath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ApiManagement/service/{serviceName}"
+
+func servicePathParameters(subscriptionId, resourceGroupName, serviceName string) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"serviceName":       autorest.Encode("path", serviceName),
+		"subscriptionId":    autorest.Encode("path", subscriptionId),
+	}
+}
+
+func queryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": apiVersion,
+	}
+}
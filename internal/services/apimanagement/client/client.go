@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/azuresdkhacks"
 )
 
 type Client struct {
@@ -37,6 +38,7 @@ type Client struct {
 	ProductGroupsClient              *apimanagement.ProductGroupClient
 	ProductPoliciesClient            *apimanagement.ProductPolicyClient
 	ServiceClient                    *apimanagement.ServiceClient
+	ServiceExtendedClient            *azuresdkhacks.ServiceClient
 	SignInClient                     *apimanagement.SignInSettingsClient
 	SignUpClient                     *apimanagement.SignUpSettingsClient
 	SubscriptionsClient              *apimanagement.SubscriptionClient
@@ -139,6 +141,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	serviceClient := apimanagement.NewServiceClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
 
+	serviceExtendedClient := azuresdkhacks.NewServiceClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&serviceExtendedClient.Client, o.ResourceManagerAuthorizer)
+
 	signInClient := apimanagement.NewSignInSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&signInClient.Client, o.ResourceManagerAuthorizer)
 
@@ -189,6 +194,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ProductGroupsClient:              &productGroupsClient,
 		ProductPoliciesClient:            &productPoliciesClient,
 		ServiceClient:                    &serviceClient,
+		ServiceExtendedClient:            &serviceExtendedClient,
 		SignInClient:                     &signInClient,
 		SignUpClient:                     &signUpClient,
 		SubscriptionsClient:              &subscriptionsClient,
@@ -63,6 +63,31 @@ func TestApimSkuName(t *testing.T) {
 			input: "PREMIUM_7",
 			valid: false,
 		},
+		{
+			name:  "BasicV2_1",
+			input: "BasicV2_1",
+			valid: true,
+		},
+		{
+			name:  "BasicV2_11",
+			input: "BasicV2_11",
+			valid: false,
+		},
+		{
+			name:  "StandardV2_10",
+			input: "StandardV2_10",
+			valid: true,
+		},
+		{
+			name:  "StandardV2_0",
+			input: "StandardV2_0",
+			valid: false,
+		},
+		{
+			name:  "standardv2_1",
+			input: "standardv2_1",
+			valid: false,
+		},
 	}
 	var validationFunction = ApimSkuName()
 	for _, tt := range tests {
@@ -1,6 +1,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -157,6 +158,13 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a classic Application Insights can be migrated to a workspace in place, but it can't be moved back again
+			pluginsdk.ForceNewIfChange("workspace_id", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) == ""
+			}),
+		),
 	}
 }
 
@@ -215,6 +223,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	if workspaceRaw, hasWorkspaceId := d.GetOk("workspace_id"); hasWorkspaceId {
 		applicationInsightsComponentProperties.WorkspaceResourceID = utils.String(workspaceRaw.(string))
+		applicationInsightsComponentProperties.IngestionMode = insights.IngestionModeLogAnalytics
 	}
 
 	if v, ok := d.GetOk("retention_in_days"); ok {
@@ -234,6 +243,22 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("creating Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	// migrating a classic Application Insights to a workspace happens asynchronously after the PUT has returned,
+	// the instrumentation key and existing telemetry are retained throughout
+	if !d.IsNewResource() && d.HasChange("workspace_id") {
+		timeout, _ := ctx.Deadline()
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{"Accepted", "Creating", "Deploying", "Updating"},
+			Target:     []string{"Succeeded"},
+			Refresh:    applicationInsightsProvisioningStateRefreshFunc(ctx, client, resGroup, name),
+			MinTimeout: 15 * time.Second,
+			Timeout:    time.Until(timeout),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for Application Insights %q (Resource Group %q) to be migrated to workspace: %+v", name, resGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
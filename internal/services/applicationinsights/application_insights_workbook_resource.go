@@ -0,0 +1,202 @@
+package applicationinsights
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// workbookDefaultSourceId is the source used by workbooks which aren't linked to a specific resource
+const workbookDefaultSourceId = "azure monitor"
+
+func resourceApplicationInsightsWorkbook() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationInsightsWorkbookCreateUpdate,
+		Read:   resourceApplicationInsightsWorkbookRead,
+		Update: resourceApplicationInsightsWorkbookCreateUpdate,
+		Delete: resourceApplicationInsightsWorkbookDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.WorkbookID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_json": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"category": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(insights.CategoryTypeWorkbook),
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  workbookDefaultSourceId,
+				ValidateFunc: validation.Any(
+					azure.ValidateResourceID,
+					validation.StringInSlice([]string{workbookDefaultSourceId}, false),
+				),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceApplicationInsightsWorkbookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.WorkbooksClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewWorkbookID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Application Insights %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_application_insights_workbook", *existing.ID)
+		}
+	}
+
+	// the serialized data is normalized so that the key order and whitespace of exported workbooks don't matter
+	serializedData := utils.NormalizeJson(d.Get("data_json").(string))
+
+	workbook := insights.Workbook{
+		Kind:     insights.SharedTypeKindShared,
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		WorkbookProperties: &insights.WorkbookProperties{
+			Name:             utils.String(d.Get("display_name").(string)),
+			SerializedData:   utils.String(serializedData),
+			Category:         utils.String(d.Get("category").(string)),
+			SharedTypeKind:   insights.SharedTypeKindShared,
+			SourceResourceID: utils.String(d.Get("source_id").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, workbook); err != nil {
+		return fmt.Errorf("creating/updating Application Insights %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationInsightsWorkbookRead(d, meta)
+}
+
+func resourceApplicationInsightsWorkbookRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.WorkbooksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WorkbookID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Insights %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.WorkbookProperties; props != nil {
+		d.Set("display_name", props.Name)
+		d.Set("category", props.Category)
+
+		sourceId := workbookDefaultSourceId
+		if props.SourceResourceID != nil && *props.SourceResourceID != "" {
+			sourceId = *props.SourceResourceID
+		}
+		d.Set("source_id", sourceId)
+
+		dataJson := ""
+		if props.SerializedData != nil {
+			dataJson = utils.NormalizeJson(*props.SerializedData)
+		}
+		d.Set("data_json", dataJson)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceApplicationInsightsWorkbookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.WorkbooksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WorkbookID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Application Insights %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
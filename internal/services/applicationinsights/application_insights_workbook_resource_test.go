@@ -0,0 +1,232 @@
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppInsightsWorkbookResource struct{}
+
+func TestAccApplicationInsightsWorkbook_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := AppInsightsWorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsWorkbook_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := AppInsightsWorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationInsightsWorkbook_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := AppInsightsWorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsWorkbook_sourceId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := AppInsightsWorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sourceId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppInsightsWorkbookResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkbookID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppInsights.WorkbooksClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (AppInsightsWorkbookResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appinsights-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r AppInsightsWorkbookResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "Test2022"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false,
+    "fallbackResourceIds" = [
+      "Azure Monitor"
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AppInsightsWorkbookResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "import" {
+  name                = azurerm_application_insights_workbook.test.name
+  resource_group_name = azurerm_application_insights_workbook.test.resource_group_name
+  location            = azurerm_application_insights_workbook.test.location
+  display_name        = azurerm_application_insights_workbook.test.display_name
+  data_json           = azurerm_application_insights_workbook.test.data_json
+}
+`, r.basic(data))
+}
+
+func (r AppInsightsWorkbookResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  category            = "workbook1"
+
+  data_json = <<JSON
+{
+  "fallbackResourceIds": ["Azure Monitor"],
+  "isLocked": false,
+  "items": [
+    {
+      "name": "text - 0",
+      "type": 1,
+      "content": {
+        "json": "Test2022Updated"
+      }
+    }
+  ],
+  "version": "Notebook/1.0"
+}
+JSON
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AppInsightsWorkbookResource) sourceId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  source_id           = azurerm_log_analytics_workspace.test.id
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "Test2022"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false,
+    "fallbackResourceIds" = [
+      "Azure Monitor"
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
@@ -12,6 +12,7 @@ type Client struct {
 	WebTestsClient           *insights.WebTestsClient
 	BillingClient            *insights.ComponentCurrentBillingFeaturesClient
 	SmartDetectionRuleClient *insights.ProactiveDetectionConfigurationsClient
+	WorkbooksClient          *insights.WorkbooksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +34,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	smartDetectionRuleClient := insights.NewProactiveDetectionConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&smartDetectionRuleClient.Client, o.ResourceManagerAuthorizer)
 
+	workbooksClient := insights.NewWorkbooksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&workbooksClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AnalyticsItemsClient:     &analyticsItemsClient,
 		APIKeysClient:            &apiKeysClient,
@@ -40,5 +44,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		WebTestsClient:           &webTestsClient,
 		BillingClient:            &billingClient,
 		SmartDetectionRuleClient: &smartDetectionRuleClient,
+		WorkbooksClient:          &workbooksClient,
 	}
 }
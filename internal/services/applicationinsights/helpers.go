@@ -1,9 +1,11 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -31,3 +33,18 @@ func flattenApplicationInsightsAPIKeyLinkedProperties(props *[]string) *[]string
 	}
 	return &result
 }
+
+func applicationInsightsProvisioningStateRefreshFunc(ctx context.Context, client *insights.ComponentsClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Application Insights %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.ApplicationInsightsComponentProperties; props != nil && props.ProvisioningState != nil {
+			return resp, *props.ProvisioningState, nil
+		}
+
+		return resp, "Succeeded", nil
+	}
+}
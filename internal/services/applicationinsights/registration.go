@@ -33,5 +33,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_application_insights_analytics_item":       resourceApplicationInsightsAnalyticsItem(),
 		"azurerm_application_insights_smart_detection_rule": resourceApplicationInsightsSmartDetectionRule(),
 		"azurerm_application_insights_web_test":             resourceApplicationInsightsWebTests(),
+		"azurerm_application_insights_workbook":             resourceApplicationInsightsWorkbook(),
 	}
 }
@@ -6,3 +6,4 @@ package applicationinsights
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApiKey -rewrite=true -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/apiKeys/apikey1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AnalyticsUserItem -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/myAnalyticsItems/item1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AnalyticsSharedItem -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/analyticsItems/item1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Workbook -rewrite=true -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/workbooks/workbook1
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -954,7 +955,7 @@ func FunctionAppAppServiceLogsSchema() *pluginsdk.Schema {
 	}
 }
 
-func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool) (*web.SiteConfig, error) {
+func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool, storageIdentityId string) (*web.SiteConfig, error) {
 	if len(siteConfig) == 0 {
 		return nil, nil
 	}
@@ -973,10 +974,7 @@ func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, e
 	})
 
 	if storageUsesMSI {
-		appSettings = append(appSettings, web.NameValuePair{
-			Name:  utils.String("AzureWebJobsStorage__accountName"),
-			Value: utils.String(storageString),
-		})
+		appSettings = append(appSettings, ExpandFunctionAppStorageIdentitySettings(storageString, metadata.Client.Account.Environment.StorageEndpointSuffix, storageIdentityId)...)
 	} else {
 		appSettings = append(appSettings, web.NameValuePair{
 			Name:  utils.String("AzureWebJobsStorage"),
@@ -1214,7 +1212,7 @@ func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, e
 	return expanded, nil
 }
 
-func ExpandSiteConfigWindowsFunctionApp(siteConfig []SiteConfigWindowsFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool) (*web.SiteConfig, error) {
+func ExpandSiteConfigWindowsFunctionApp(siteConfig []SiteConfigWindowsFunctionApp, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool, storageIdentityId string) (*web.SiteConfig, error) {
 	if len(siteConfig) == 0 {
 		return nil, nil
 	}
@@ -1233,10 +1231,7 @@ func ExpandSiteConfigWindowsFunctionApp(siteConfig []SiteConfigWindowsFunctionAp
 	})
 
 	if storageUsesMSI {
-		appSettings = append(appSettings, web.NameValuePair{
-			Name:  utils.String("AzureWebJobsStorage__accountName"),
-			Value: utils.String(storageString),
-		})
+		appSettings = append(appSettings, ExpandFunctionAppStorageIdentitySettings(storageString, metadata.Client.Account.Environment.StorageEndpointSuffix, storageIdentityId)...)
 	} else {
 		appSettings = append(appSettings, web.NameValuePair{
 			Name:  utils.String("AzureWebJobsStorage"),
@@ -1597,6 +1592,45 @@ func FlattenSiteConfigWindowsFunctionApp(functionAppSiteConfig *web.SiteConfig)
 	return result, nil
 }
 
+// ExpandFunctionAppStorageIdentitySettings returns the `AzureWebJobsStorage` settings for an identity based connection to the
+// storage account, which allows the Function App to run against an account with Shared Key access disabled.
+// The System Assigned identity is used unless the resource ID of a User Assigned identity is supplied.
+func ExpandFunctionAppStorageIdentitySettings(accountName, endpointSuffix, identityId string) []web.NameValuePair {
+	result := make([]web.NameValuePair, 0)
+	for _, service := range []string{"blob", "queue", "table"} {
+		result = append(result, web.NameValuePair{
+			Name:  utils.String(fmt.Sprintf("AzureWebJobsStorage__%sServiceUri", service)),
+			Value: utils.String(fmt.Sprintf("https://%s.%s.%s", accountName, service, endpointSuffix)),
+		})
+	}
+
+	if identityId != "" {
+		result = append(result, web.NameValuePair{
+			Name:  utils.String("AzureWebJobsStorage__credential"),
+			Value: utils.String("managedidentity"),
+		}, web.NameValuePair{
+			Name:  utils.String("AzureWebJobsStorage__managedIdentityResourceId"),
+			Value: utils.String(identityId),
+		})
+	}
+
+	return result
+}
+
+// ParseWebJobsStorageServiceUri returns the storage account name from an `AzureWebJobsStorage__<service>ServiceUri` setting
+func ParseWebJobsStorageServiceUri(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	u, err := url.Parse(*input)
+	if err != nil {
+		return ""
+	}
+
+	return strings.Split(u.Hostname(), ".")[0]
+}
+
 func ParseWebJobsStorageString(input *string) (name, key string) {
 	if input == nil {
 		return
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -1327,9 +1328,10 @@ type AutoHealTriggerWindows struct {
 }
 
 type AutoHealTriggerLinux struct {
-	Requests     []AutoHealRequestTrigger    `tfschema:"requests"`
-	StatusCodes  []AutoHealStatusCodeTrigger `tfschema:"status_code"` // 0 or more, ranges split by `-`, ranges cannot use sub-status or win32 code
-	SlowRequests []AutoHealSlowRequest       `tfschema:"slow_request"`
+	Requests        []AutoHealRequestTrigger    `tfschema:"requests"`
+	PrivateMemoryKB int                         `tfschema:"private_memory_kb"`
+	StatusCodes     []AutoHealStatusCodeTrigger `tfschema:"status_code"` // 0 or more, ranges split by `-`, ranges cannot use sub-status or win32 code
+	SlowRequests    []AutoHealSlowRequest       `tfschema:"slow_request"`
 }
 
 type AutoHealRequestTrigger struct {
@@ -1360,8 +1362,9 @@ type AutoHealActionWindows struct {
 }
 
 type AutoHealActionLinux struct {
-	ActionType         string `tfschema:"action_type"`                    // Enum - Only `Recycle` allowed
-	MinimumProcessTime string `tfschema:"minimum_process_execution_time"` // Minimum uptime for process before action will trigger
+	ActionType         string                 `tfschema:"action_type"`                    // Enum
+	CustomAction       []AutoHealCustomAction `tfschema:"custom_action"`                  // Max: 1, needs `action_type` to be "CustomAction"
+	MinimumProcessTime string                 `tfschema:"minimum_process_execution_time"` // Minimum uptime for process before action will trigger
 }
 
 type AutoHealCustomAction struct {
@@ -1531,10 +1534,33 @@ func autoHealActionSchemaLinux() *pluginsdk.Schema {
 					Type:     pluginsdk.TypeString,
 					Required: true,
 					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AutoHealActionTypeCustomAction),
+						string(web.AutoHealActionTypeLogEvent),
 						string(web.AutoHealActionTypeRecycle),
 					}, false),
 				},
 
+				"custom_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"executable": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
 				"minimum_process_execution_time": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -1557,6 +1583,24 @@ func autoHealActionSchemaLinuxComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"custom_action": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"executable": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+
 				"minimum_process_execution_time": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -1809,6 +1853,12 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 					},
 				},
 
+				"private_memory_kb": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(102400, 13631488),
+				},
+
 				"status_code": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -1913,6 +1963,11 @@ func autoHealTriggerSchemaLinuxComputed() *pluginsdk.Schema {
 					},
 				},
 
+				"private_memory_kb": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
 				"status_code": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -1953,7 +2008,7 @@ func autoHealTriggerSchemaLinuxComputed() *pluginsdk.Schema {
 
 				"slow_request": {
 					Type:     pluginsdk.TypeList,
-					Optional: true,
+					Computed: true,
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"time_taken": {
@@ -2309,11 +2364,11 @@ func BackupSchema() *pluginsdk.Schema {
 							},
 
 							"start_time": {
-								Type:     pluginsdk.TypeString,
-								Optional: true,
-								Computed: true,
-								//DiffSuppressFunc: suppress.RFC3339Time,
-								//ValidateFunc:     validation.IsRFC3339Time,
+								Type:             pluginsdk.TypeString,
+								Optional:         true,
+								Computed:         true,
+								DiffSuppressFunc: suppress.RFC3339Time,
+								ValidateFunc:     validation.IsRFC3339Time,
 							},
 
 							"last_execution_time": {
@@ -2464,6 +2519,48 @@ func ConnectionStringSchemaComputed() *pluginsdk.Schema {
 	}
 }
 
+type StickySettings struct {
+	AppSettingNames       []string `tfschema:"app_setting_names"`
+	ConnectionStringNames []string `tfschema:"connection_string_names"`
+}
+
+func StickySettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_setting_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+				},
+
+				"connection_string_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+				},
+			},
+		},
+	}
+}
+
 type LogsConfig struct {
 	ApplicationLogs       []ApplicationLog `tfschema:"application_logs"`
 	HttpLogs              []HttpLog        `tfschema:"http_logs"`
@@ -3659,6 +3756,46 @@ func FlattenConnectionStrings(appConnectionStrings web.ConnectionStringDictionar
 	return connectionStrings
 }
 
+// ExpandStickySettings returns the Slot Config Names for the app, always sending empty lists so that removing
+// `sticky_settings` clears any names previously configured on the service.
+func ExpandStickySettings(input []StickySettings) web.SlotConfigNamesResource {
+	appSettingNames := make([]string, 0)
+	connectionStringNames := make([]string, 0)
+
+	if len(input) == 1 {
+		appSettingNames = append(appSettingNames, input[0].AppSettingNames...)
+		connectionStringNames = append(connectionStringNames, input[0].ConnectionStringNames...)
+	}
+
+	return web.SlotConfigNamesResource{
+		SlotConfigNames: &web.SlotConfigNames{
+			AppSettingNames:       &appSettingNames,
+			ConnectionStringNames: &connectionStringNames,
+		},
+	}
+}
+
+func FlattenStickySettings(input *web.SlotConfigNames) []StickySettings {
+	if input == nil {
+		return nil
+	}
+
+	result := StickySettings{}
+	if input.AppSettingNames != nil {
+		result.AppSettingNames = *input.AppSettingNames
+	}
+
+	if input.ConnectionStringNames != nil {
+		result.ConnectionStringNames = *input.ConnectionStringNames
+	}
+
+	if len(result.AppSettingNames) == 0 && len(result.ConnectionStringNames) == 0 {
+		return nil
+	}
+
+	return []StickySettings{result}
+}
+
 func ExpandAppSettings(settings map[string]string) *web.StringDictionary {
 	appSettings := make(map[string]*string)
 	for k, v := range settings {
@@ -3935,6 +4072,10 @@ func expandAutoHealSettingsLinux(autoHealSettings []AutoHealSettingLinux) *web.A
 		}
 	}
 
+	if triggers.PrivateMemoryKB != 0 {
+		result.Triggers.PrivateBytesInKB = utils.Int32(int32(triggers.PrivateMemoryKB))
+	}
+
 	if len(triggers.StatusCodes) > 0 {
 		statusCodeTriggers := make([]web.StatusCodesBasedTrigger, 0)
 		statusCodeRangeTriggers := make([]web.StatusCodesRangeBasedTrigger, 0)
@@ -3967,9 +4108,39 @@ func expandAutoHealSettingsLinux(autoHealSettings []AutoHealSettingLinux) *web.A
 		result.Triggers.StatusCodesRange = &statusCodeRangeTriggers
 	}
 
+	if len(triggers.SlowRequests) > 0 {
+		// the API only supports a single slow request trigger without a path, any others must be path based
+		slowRequestsWithPath := make([]web.SlowRequestsBasedTrigger, 0)
+		for _, sr := range triggers.SlowRequests {
+			slowRequest := web.SlowRequestsBasedTrigger{
+				TimeTaken:    utils.String(sr.TimeTaken),
+				TimeInterval: utils.String(sr.Interval),
+				Count:        utils.Int32(int32(sr.Count)),
+			}
+			if sr.Path == "" && result.Triggers.SlowRequests == nil {
+				result.Triggers.SlowRequests = &slowRequest
+				continue
+			}
+			if sr.Path != "" {
+				slowRequest.Path = utils.String(sr.Path)
+			}
+			slowRequestsWithPath = append(slowRequestsWithPath, slowRequest)
+		}
+		if len(slowRequestsWithPath) > 0 {
+			result.Triggers.SlowRequestsWithPath = &slowRequestsWithPath
+		}
+	}
+
 	action := autoHeal.Actions[0]
 	result.Actions.ActionType = web.AutoHealActionType(action.ActionType)
 	result.Actions.MinProcessExecutionTime = utils.String(action.MinimumProcessTime)
+	if len(action.CustomAction) != 0 {
+		customAction := action.CustomAction[0]
+		result.Actions.CustomAction = &web.AutoHealCustomAction{
+			Exe:        utils.String(customAction.Executable),
+			Parameters: utils.String(customAction.Parameters),
+		}
+	}
 
 	return result
 }
@@ -3996,6 +4167,10 @@ func flattenAutoHealSettingsLinux(autoHealRules *web.AutoHealRules) []AutoHealSe
 			}}
 		}
 
+		if privateBytes := triggers.PrivateBytesInKB; privateBytes != nil && *privateBytes != 0 {
+			resultTrigger.PrivateMemoryKB = int(*triggers.PrivateBytesInKB)
+		}
+
 		statusCodeTriggers := make([]AutoHealStatusCodeTrigger, 0)
 		if triggers.StatusCodes != nil {
 			for _, s := range *triggers.StatusCodes {
@@ -4045,6 +4220,16 @@ func flattenAutoHealSettingsLinux(autoHealRules *web.AutoHealRules) []AutoHealSe
 				Path:      utils.NormalizeNilableString(triggers.SlowRequests.Path),
 			})
 		}
+		if triggers.SlowRequestsWithPath != nil {
+			for _, sr := range *triggers.SlowRequestsWithPath {
+				slowRequestTriggers = append(slowRequestTriggers, AutoHealSlowRequest{
+					TimeTaken: utils.NormalizeNilableString(sr.TimeTaken),
+					Interval:  utils.NormalizeNilableString(sr.TimeInterval),
+					Count:     int(utils.NormaliseNilableInt32(sr.Count)),
+					Path:      utils.NormalizeNilableString(sr.Path),
+				})
+			}
+		}
 		resultTrigger.SlowRequests = slowRequestTriggers
 		result.Triggers = []AutoHealTriggerLinux{resultTrigger}
 	}
@@ -4052,9 +4237,17 @@ func flattenAutoHealSettingsLinux(autoHealRules *web.AutoHealRules) []AutoHealSe
 	// Actions
 	if autoHealRules.Actions != nil {
 		actions := *autoHealRules.Actions
+		customActions := make([]AutoHealCustomAction, 0)
+		if actions.CustomAction != nil {
+			customActions = append(customActions, AutoHealCustomAction{
+				Executable: utils.NormalizeNilableString(actions.CustomAction.Exe),
+				Parameters: utils.NormalizeNilableString(actions.CustomAction.Parameters),
+			})
+		}
 
 		result.Actions = []AutoHealActionLinux{{
 			ActionType:         string(actions.ActionType),
+			CustomAction:       customActions,
 			MinimumProcessTime: utils.NormalizeNilableString(actions.MinProcessExecutionTime),
 		}}
 	}
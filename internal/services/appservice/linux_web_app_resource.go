@@ -52,6 +52,7 @@ type LinuxWebAppModel struct {
 	PossibleOutboundIPAddresses   string                     `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string                   `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential   `tfschema:"site_credential"`
+	StickySettings                []helpers.StickySettings   `tfschema:"sticky_settings"`
 }
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppResource{}
@@ -142,6 +143,8 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchema(),
 
+		"sticky_settings": helpers.StickySettingsSchema(),
+
 		"tags": tags.Schema(),
 	}
 }
@@ -368,6 +371,13 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webApp.StickySettings) > 0 {
+				stickySettings := helpers.ExpandStickySettings(webApp.StickySettings)
+				if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, stickySettings); err != nil {
+					return fmt.Errorf("setting Sticky Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -433,6 +443,11 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
+			stickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
@@ -492,6 +507,8 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			state.StickySettings = helpers.FlattenStickySettings(stickySettings.SlotConfigNames)
+
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			return metadata.Encode(&state)
@@ -616,6 +633,22 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("sticky_settings") {
+				existingStickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil {
+					return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
+				}
+
+				stickySettingsUpdate := helpers.ExpandStickySettings(state.StickySettings)
+				// Storage account mounts can also be sticky but aren't managed here, so preserve any existing names
+				if existingStickySettings.SlotConfigNames != nil {
+					stickySettingsUpdate.SlotConfigNames.AzureStorageConfigNames = existingStickySettings.SlotConfigNames.AzureStorageConfigNames
+				}
+				if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, stickySettingsUpdate); err != nil {
+					return fmt.Errorf("updating Sticky Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, *authUpdate); err != nil {
@@ -44,6 +44,8 @@ func (r Registration) Resources() []sdk.Resource {
 			ServicePlanResource{},
 			WindowsWebAppResource{},
 			WindowsFunctionAppResource{},
+			WebAppSlotSwapResource{},
+			WebAppRestoreResource{},
 		}
 	}
 	return []sdk.Resource{}
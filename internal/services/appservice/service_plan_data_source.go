@@ -31,6 +31,7 @@ type ServicePlanDataSourceModel struct {
 	Reserved                  bool              `tfschema:"reserved"`
 	WorkerCount               int               `tfschema:"worker_count"`
 	MaximumElasticWorkerCount int               `tfschema:"maximum_elastic_worker_count"`
+	ZoneBalancing             bool              `tfschema:"zone_balancing_enabled"`
 	Tags                      map[string]string `tfschema:"tags"`
 }
 
@@ -88,6 +89,11 @@ func (r ServicePlanDataSource) Attributes() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"zone_balancing_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
 		"kind": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -155,6 +161,10 @@ func (r ServicePlanDataSource) Read() sdk.ResourceFunc {
 					servicePlan.Reserved = *v
 				}
 
+				if v := props.ZoneRedundant; v != nil {
+					servicePlan.ZoneBalancing = *v
+				}
+
 				servicePlan.MaximumElasticWorkerCount = int(utils.NormaliseNilableInt32(props.MaximumElasticWorkerCount))
 			}
 			servicePlan.Tags = tags.ToTypedObject(existing.Tags)
@@ -27,6 +27,8 @@ type ServicePlanResource struct{}
 
 var _ sdk.ResourceWithUpdate = ServicePlanResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = ServicePlanResource{}
+
 type OSType string
 
 const (
@@ -47,6 +49,7 @@ type ServicePlanModel struct {
 	Reserved                  bool              `tfschema:"reserved"`
 	WorkerCount               int               `tfschema:"worker_count"`
 	MaximumElasticWorkerCount int               `tfschema:"maximum_elastic_worker_count"`
+	ZoneBalancing             bool              `tfschema:"zone_balancing_enabled"`
 	Tags                      map[string]string `tfschema:"tags"`
 	// TODO properties
 	// KubernetesID string `tfschema:"kubernetes_id"` // AKS Cluster resource ID?
@@ -122,6 +125,12 @@ func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.IntAtLeast(0),
 		},
 
+		"zone_balancing_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"tags": tags.Schema(),
 	}
 }
@@ -175,6 +184,7 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 					PerSiteScaling: utils.Bool(servicePlan.PerSiteScaling),
 					Reserved:       utils.Bool(servicePlan.OSType == OSTypeLinux),
 					HyperV:         utils.Bool(servicePlan.OSType == OSTypeWindowsContainer),
+					ZoneRedundant:  utils.Bool(servicePlan.ZoneBalancing),
 				},
 				Sku: &web.SkuDescription{
 					Name: utils.String(servicePlan.Sku),
@@ -276,6 +286,10 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 					state.Reserved = *v
 				}
 
+				if v := props.ZoneRedundant; v != nil {
+					state.ZoneBalancing = *v
+				}
+
 				state.MaximumElasticWorkerCount = int(utils.NormaliseNilableInt32(props.MaximumElasticWorkerCount))
 			}
 			state.Tags = tags.ToTypedObject(servicePlan.Tags)
@@ -345,8 +359,12 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				existing.Sku.Capacity = utils.Int32(int32(state.WorkerCount))
 			}
 
+			if metadata.ResourceData.HasChange("zone_balancing_enabled") {
+				existing.AppServicePlanProperties.ZoneRedundant = utils.Bool(state.ZoneBalancing)
+			}
+
 			if metadata.ResourceData.HasChange("maximum_elastic_worker_count") {
-				if metadata.ResourceData.HasChange("maximum_elastic_worker_count") && !strings.HasPrefix(state.Sku, "EP") && !strings.HasPrefix(state.Sku, "PC") {
+				if !strings.HasPrefix(state.Sku, "EP") && !strings.HasPrefix(state.Sku, "PC") {
 					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus")
 				}
 				existing.AppServicePlanProperties.MaximumElasticWorkerCount = utils.Int32(int32(state.MaximumElasticWorkerCount))
@@ -365,3 +383,95 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r ServicePlanResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			var servicePlan ServicePlanModel
+			if err := metadata.DecodeDiff(&servicePlan); err != nil {
+				return err
+			}
+
+			if servicePlan.Sku == "" {
+				// sku_name is not known until apply
+				return nil
+			}
+
+			if maxWorkers, ok := servicePlanMaximumWorkerCount(servicePlan.Sku); ok && servicePlan.WorkerCount > maxWorkers {
+				return fmt.Errorf("`worker_count` cannot be greater than %d for the %q SKU", maxWorkers, servicePlan.Sku)
+			}
+
+			if servicePlan.PerSiteScaling && !servicePlanSkuSupportsPerSiteScaling(servicePlan.Sku) {
+				return fmt.Errorf("`per_site_scaling_enabled` is only supported with Standard, Premium, Isolated, Elastic Premium and Workflow SKUs")
+			}
+
+			if servicePlan.ZoneBalancing {
+				if !servicePlanSkuSupportsZoneBalancing(servicePlan.Sku) {
+					return fmt.Errorf("`zone_balancing_enabled` is only supported with Premium v2, Premium v3, Isolated v2, Elastic Premium and Workflow SKUs")
+				}
+				if servicePlan.WorkerCount != 0 && servicePlan.WorkerCount < 3 {
+					return fmt.Errorf("`worker_count` must be at least 3 when `zone_balancing_enabled` is `true`")
+				}
+			}
+
+			// ARM can only toggle zone balancing in place on Premium v2/v3 plans, all other SKUs require the plan to be recreated
+			if rd.HasChange("zone_balancing_enabled") && rd.Id() != "" {
+				if rd.HasChange("sku_name") || !isPremiumV2OrV3Sku(servicePlan.Sku) {
+					if err := rd.ForceNew("zone_balancing_enabled"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// servicePlanMaximumWorkerCount returns the maximum number of instances for a SKU, if the limit is known
+func servicePlanMaximumWorkerCount(sku string) (int, bool) {
+	switch {
+	case sku == "F1" || sku == "FREE" || sku == "D1" || sku == "SHARED":
+		return 1, true
+	case strings.HasPrefix(sku, "B"):
+		return 3, true
+	case strings.HasPrefix(sku, "S"):
+		return 10, true
+	case isPremiumV2OrV3Sku(sku):
+		return 30, true
+	case strings.HasPrefix(sku, "I"):
+		return 100, true
+	case strings.HasPrefix(sku, "EP") || strings.HasPrefix(sku, "WS"):
+		return 20, true
+	}
+
+	return 0, false
+}
+
+func servicePlanSkuSupportsPerSiteScaling(sku string) bool {
+	for _, prefix := range []string{"S", "P", "I", "EP", "WS"} {
+		if strings.HasPrefix(sku, prefix) && sku != "SHARED" {
+			return true
+		}
+	}
+	return false
+}
+
+func servicePlanSkuSupportsZoneBalancing(sku string) bool {
+	switch {
+	case isPremiumV2OrV3Sku(sku):
+		return true
+	case strings.HasPrefix(sku, "I") && strings.HasSuffix(sku, "v2"):
+		return true
+	case strings.HasPrefix(sku, "EP") || strings.HasPrefix(sku, "WS"):
+		return true
+	}
+	return false
+}
+
+func isPremiumV2OrV3Sku(sku string) bool {
+	return strings.HasPrefix(sku, "P") && (strings.HasSuffix(sku, "v2") || strings.HasSuffix(sku, "v3"))
+}
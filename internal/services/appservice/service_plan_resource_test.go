@@ -3,6 +3,7 @@ package appservice_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -119,6 +120,41 @@ func TestAccServicePlan_maxElasticWorkerCount(t *testing.T) {
 	})
 }
 
+func TestAccServicePlan_zoneBalancingUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_plan", "test")
+	r := ServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zoneBalancing(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.zoneBalancing(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_balancing_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePlan_workerCountExceedsSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_plan", "test")
+	r := ServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.workerCount(data, "B1", 4),
+			ExpectError: regexp.MustCompile("`worker_count` cannot be greater than 3"),
+		},
+	})
+}
+
 // ASE tests given longer prefix to allow them to be more easily filtered out due to exceptionally long running time
 func TestAccServicePlanIsolated_appServiceEnvironmentV2(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_service_plan", "test")
@@ -322,6 +358,51 @@ resource "azurerm_service_plan" "test" {
 `, data.RandomInteger, data.Locations.Primary, count)
 }
 
+func (r ServicePlanResource) zoneBalancing(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appserviceplan-%[1]d"
+  location = "%s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                   = "acctest-SP-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  sku_name               = "P1v3"
+  os_type                = "Linux"
+  worker_count           = 3
+  zone_balancing_enabled = %[3]t
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
+
+func (r ServicePlanResource) workerCount(data acceptance.TestData, sku string, count int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appserviceplan-%[1]d"
+  location = "%s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctest-SP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "%[3]s"
+  os_type             = "Linux"
+  worker_count        = %[4]d
+}
+`, data.RandomInteger, data.Locations.Primary, sku, count)
+}
+
 func (r ServicePlanResource) aseV2(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
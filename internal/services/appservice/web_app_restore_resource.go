@@ -0,0 +1,180 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppRestoreResource struct{}
+
+type WebAppRestoreModel struct {
+	AppId                      string `tfschema:"app_id"`
+	StorageAccountUrl          string `tfschema:"storage_account_url"`
+	BlobName                   string `tfschema:"blob_name"`
+	Overwrite                  bool   `tfschema:"overwrite"`
+	IgnoreConflictingHostNames bool   `tfschema:"ignore_conflicting_host_names"`
+	IgnoreDatabases            bool   `tfschema:"ignore_databases"`
+}
+
+var _ sdk.Resource = WebAppRestoreResource{}
+
+func (r WebAppRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppID,
+		},
+
+		"storage_account_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"blob_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"overwrite": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"ignore_conflicting_host_names": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"ignore_databases": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppRestoreResource) ModelObject() interface{} {
+	return &WebAppRestoreModel{}
+}
+
+func (r WebAppRestoreResource) ResourceType() string {
+	return "azurerm_web_app_restore"
+}
+
+func (r WebAppRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var restore WebAppRestoreModel
+			if err := metadata.Decode(&restore); err != nil {
+				return err
+			}
+
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(restore.AppId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Get(ctx, id.ResourceGroup, id.SiteName); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			request := web.RestoreRequest{
+				RestoreRequestProperties: &web.RestoreRequestProperties{
+					StorageAccountURL:          utils.String(restore.StorageAccountUrl),
+					BlobName:                   utils.String(restore.BlobName),
+					Overwrite:                  utils.Bool(restore.Overwrite),
+					SiteName:                   utils.String(id.SiteName),
+					IgnoreConflictingHostNames: utils.Bool(restore.IgnoreConflictingHostNames),
+					IgnoreDatabases:            utils.Bool(restore.IgnoreDatabases),
+					OperationType:              web.BackupRestoreOperationTypeDefault,
+				},
+			}
+
+			future, err := client.RestoreFromBackupBlob(ctx, id.ResourceGroup, id.SiteName, request)
+			if err != nil {
+				return fmt.Errorf("restoring %s from backup %q: %+v", id, restore.BlobName, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for restore of %s from backup %q: %+v", id, restore.BlobName, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webApp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(webApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the restore is an action rather than a resource in Azure, so everything but the app comes from config/state
+			var state WebAppRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			state.AppId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a restore can't be undone, so removing it only removes it from state
+			return nil
+		},
+	}
+}
+
+func (r WebAppRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppID
+}
@@ -0,0 +1,66 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppRestoreResource struct{}
+
+func TestAccWebAppRestore_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL") == "" || os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME") == "" {
+		t.Skip("Skipping as ARM_TEST_WEB_APP_BACKUP_STORAGE_URL and/or ARM_TEST_WEB_APP_BACKUP_BLOB_NAME are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_web_app_restore", "test")
+	r := WebAppRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r WebAppRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WebAppRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_restore" "test" {
+  app_id                        = azurerm_linux_web_app.test.id
+  storage_account_url           = "%s"
+  blob_name                     = "%s"
+  ignore_conflicting_host_names = true
+}
+`, LinuxWebAppResource{}.basic(data), os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL"), os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME"))
+}
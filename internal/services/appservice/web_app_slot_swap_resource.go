@@ -0,0 +1,222 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	slotSwapPhaseComplete = "Complete"
+	slotSwapPhasePreview  = "Preview"
+)
+
+type WebAppSlotSwapResource struct{}
+
+type WebAppSlotSwapModel struct {
+	SlotId       string `tfschema:"slot_id"`
+	Phase        string `tfschema:"phase"`
+	PreserveVnet bool   `tfschema:"preserve_vnet"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppSlotSwapResource{}
+
+func (r WebAppSlotSwapResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"slot_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppSlotID,
+		},
+
+		"phase": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  slotSwapPhaseComplete,
+			ValidateFunc: validation.StringInSlice([]string{
+				slotSwapPhaseComplete,
+				slotSwapPhasePreview,
+			}, false),
+		},
+
+		"preserve_vnet": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppSlotSwapResource) ModelObject() interface{} {
+	return &WebAppSlotSwapModel{}
+}
+
+func (r WebAppSlotSwapResource) ResourceType() string {
+	return "azurerm_web_app_slot_swap"
+}
+
+func (r WebAppSlotSwapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var slotSwap WebAppSlotSwapModel
+			if err := metadata.Decode(&slotSwap); err != nil {
+				return err
+			}
+
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotID(slotSwap.SlotId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := swapWebAppSlot(ctx, metadata, *id, slotSwap); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			slot, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(slot.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the swap itself isn't a resource in Azure, so the phase and preserve_vnet can only come from config/state
+			var state WebAppSlotSwapModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			state.SlotId = id.ID()
+			if state.Phase == "" {
+				state.Phase = slotSwapPhaseComplete
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.WebAppSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var slotSwap WebAppSlotSwapModel
+			if err := metadata.Decode(&slotSwap); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("phase") {
+				if err := swapWebAppSlot(ctx, metadata, *id, slotSwap); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var slotSwap WebAppSlotSwapModel
+			if err := metadata.Decode(&slotSwap); err != nil {
+				return err
+			}
+
+			// a completed swap can't be undone other than by swapping again, however a swap which is still in the
+			// preview phase is cancelled by resetting the production slot configuration
+			if slotSwap.Phase == slotSwapPhasePreview {
+				if _, err := client.ResetProductionSlotConfig(ctx, id.ResourceGroup, id.SiteName); err != nil {
+					return fmt.Errorf("cancelling the swap preview for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppSlotID
+}
+
+func swapWebAppSlot(ctx context.Context, metadata sdk.ResourceMetaData, id parse.WebAppSlotId, slotSwap WebAppSlotSwapModel) error {
+	client := metadata.Client.AppService.WebAppsClient
+
+	slotEntity := web.CsmSlotEntity{
+		TargetSlot:   utils.String(id.SlotName),
+		PreserveVnet: utils.Bool(slotSwap.PreserveVnet),
+	}
+
+	// the preview phase applies the configuration of the production slot to the source slot and waits there, so that
+	// the source slot can be warmed up and validated before the swap is completed
+	if slotSwap.Phase == slotSwapPhasePreview {
+		if _, err := client.ApplySlotConfigToProduction(ctx, id.ResourceGroup, id.SiteName, slotEntity); err != nil {
+			return fmt.Errorf("starting the swap with preview for %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	future, err := client.SwapSlotWithProduction(ctx, id.ResourceGroup, id.SiteName, slotEntity)
+	if err != nil {
+		return fmt.Errorf("swapping %s with production: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the swap of %s with production: %+v", id, err)
+	}
+
+	return nil
+}
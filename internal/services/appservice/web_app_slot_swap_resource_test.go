@@ -0,0 +1,82 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppSlotSwapResource struct{}
+
+func TestAccWebAppSlotSwap_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	r := WebAppSlotSwapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.swap(data, "Complete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the phase and preserve_vnet arguments only describe how the swap was performed
+		data.ImportStep("preserve_vnet"),
+	})
+}
+
+func TestAccWebAppSlotSwap_preview(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	r := WebAppSlotSwapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.swap(data, "Preview"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phase").HasValue("Preview"),
+			),
+		},
+		{
+			Config: r.swap(data, "Complete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phase").HasValue("Complete"),
+			),
+		},
+	})
+}
+
+func (r WebAppSlotSwapResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppSlotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WebAppSlotSwapResource) swap(data acceptance.TestData, phase string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_slot_swap" "test" {
+  slot_id = azurerm_linux_web_app_slot.test.id
+  phase   = "%s"
+}
+`, LinuxWebAppSlotResource{}.basic(data), phase)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -33,6 +34,7 @@ type WindowsFunctionAppModel struct {
 
 	StorageAccountKey string `tfschema:"storage_account_access_key"`
 	StorageUsesMSI    bool   `tfschema:"storage_uses_managed_identity"` // Storage uses MSI not account key
+	StorageIdentityId string `tfschema:"storage_user_assigned_identity_id"`
 
 	AppSettings               map[string]string                      `tfschema:"app_settings"`
 	AuthSettings              []helpers.AuthSettings                 `tfschema:"auth_settings"`
@@ -131,6 +133,16 @@ func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Description: "Should the Function App use its Managed Identity to access storage",
 		},
 
+		"storage_user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: msivalidate.UserAssignedIdentityID,
+			RequiredWith: []string{
+				"storage_uses_managed_identity",
+			},
+			Description: "The ID of the User Assigned Identity which should be used to access storage. If not specified, the System Assigned Identity of the Function App is used.",
+		},
+
 		"app_settings": {
 			Type:     pluginsdk.TypeMap,
 			Optional: true,
@@ -357,7 +369,7 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 			if !functionApp.StorageUsesMSI {
 				storageString = fmt.Sprintf(helpers.StorageStringFmt, functionApp.StorageAccountName, functionApp.StorageAccountKey, metadata.Client.Account.Environment.StorageEndpointSuffix)
 			}
-			siteConfig, err := helpers.ExpandSiteConfigWindowsFunctionApp(functionApp.SiteConfig, nil, metadata, functionApp.FunctionExtensionsVersion, storageString, functionApp.StorageUsesMSI)
+			siteConfig, err := helpers.ExpandSiteConfigWindowsFunctionApp(functionApp.SiteConfig, nil, metadata, functionApp.FunctionExtensionsVersion, storageString, functionApp.StorageUsesMSI, functionApp.StorageIdentityId)
 			if err != nil {
 				return fmt.Errorf("expanding site_config for Windows %s: %+v", id, err)
 			}
@@ -373,6 +385,10 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 			if sendContentSettings {
+				if functionApp.StorageUsesMSI {
+					// Azure Files does not support identity based connections for the content share
+					return fmt.Errorf("`storage_uses_managed_identity` cannot be used for the content share of %s, set `storage_account_access_key` or `content_share_force_disabled`", id)
+				}
 				if functionApp.AppSettings == nil {
 					functionApp.AppSettings = make(map[string]string)
 				}
@@ -632,18 +648,22 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
 
-			storageString := ""
+			storageString := state.StorageAccountName
 			if !state.StorageUsesMSI {
 				storageString = fmt.Sprintf(helpers.StorageStringFmt, state.StorageAccountName, state.StorageAccountKey, metadata.Client.Account.Environment.StorageEndpointSuffix)
 			}
 
 			// Note: We process this regardless to give us a "clean" view of service-side app_settings, so we can reconcile the user-defined entries later
-			siteConfig, err := helpers.ExpandSiteConfigWindowsFunctionApp(state.SiteConfig, existing.SiteConfig, metadata, state.FunctionExtensionsVersion, storageString, state.StorageUsesMSI)
+			siteConfig, err := helpers.ExpandSiteConfigWindowsFunctionApp(state.SiteConfig, existing.SiteConfig, metadata, state.FunctionExtensionsVersion, storageString, state.StorageUsesMSI, state.StorageIdentityId)
 			if state.BuiltinLogging {
-				if state.AppSettings == nil && !state.StorageUsesMSI {
+				if state.AppSettings == nil {
 					state.AppSettings = make(map[string]string)
 				}
-				state.AppSettings["AzureWebJobsDashboard"] = storageString
+				if !state.StorageUsesMSI {
+					state.AppSettings["AzureWebJobsDashboard"] = storageString
+				} else {
+					state.AppSettings["AzureWebJobsDashboard__accountName"] = state.StorageAccountName
+				}
 			}
 
 			if metadata.ResourceData.HasChange("site_config") {
@@ -855,7 +875,20 @@ func (m *WindowsFunctionAppModel) unpackWindowsFunctionAppSettings(input web.Str
 		case "AzureWebJobsStorage":
 			m.StorageAccountName, m.StorageAccountKey = helpers.ParseWebJobsStorageString(v)
 
-		case "AzureWebJobsDashboard":
+		case "AzureWebJobsStorage__accountName":
+			m.StorageUsesMSI = true
+			m.StorageAccountName = utils.NormalizeNilableString(v)
+
+		case "AzureWebJobsStorage__blobServiceUri":
+			m.StorageUsesMSI = true
+			m.StorageAccountName = helpers.ParseWebJobsStorageServiceUri(v)
+
+		case "AzureWebJobsStorage__queueServiceUri", "AzureWebJobsStorage__tableServiceUri", "AzureWebJobsStorage__credential":
+
+		case "AzureWebJobsStorage__managedIdentityResourceId":
+			m.StorageIdentityId = utils.NormalizeNilableString(v)
+
+		case "AzureWebJobsDashboard", "AzureWebJobsDashboard__accountName":
 			m.BuiltinLogging = true
 
 		case "WEBSITE_HEALTHCHECK_MAXPINGFAILURES":
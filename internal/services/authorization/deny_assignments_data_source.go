@@ -0,0 +1,279 @@
+package authorization
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmDenyAssignments() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmDenyAssignmentsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.Any(
+					billingValidate.EnrollmentID,
+					commonids.ValidateManagementGroupID,
+					commonids.ValidateSubscriptionID,
+					commonids.ValidateResourceGroupID,
+					azure.ValidateResourceID,
+				),
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"deny_assignments": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"scope": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"apply_to_child_scopes": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"is_system_protected": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"actions": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"not_actions": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"data_actions": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"not_data_actions": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"principals": denyAssignmentPrincipalsSchema(),
+
+						"excluded_principals": denyAssignmentPrincipalsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func denyAssignmentPrincipalsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmDenyAssignmentsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.DenyAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+
+	filter := ""
+	if principalId := d.Get("principal_id").(string); principalId != "" {
+		filter = fmt.Sprintf("principalId eq '%s'", principalId)
+	}
+
+	denyAssignments := make([]authorization.DenyAssignment, 0)
+	iterator, err := client.ListForScopeComplete(ctx, scope, filter)
+	if err != nil {
+		return fmt.Errorf("listing Deny Assignments for Scope %q: %+v", scope, err)
+	}
+	for iterator.NotDone() {
+		denyAssignments = append(denyAssignments, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Deny Assignments for Scope %q: %+v", scope, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/providers/Microsoft.Authorization/denyAssignments", strings.TrimSuffix(scope, "/")))
+
+	if err := d.Set("deny_assignments", flattenDenyAssignments(denyAssignments)); err != nil {
+		return fmt.Errorf("setting `deny_assignments`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDenyAssignments(input []authorization.DenyAssignment) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		name := ""
+		description := ""
+		scope := ""
+		applyToChildScopes := true
+		isSystemProtected := false
+		permissions := make([]interface{}, 0)
+		principals := make([]interface{}, 0)
+		excludedPrincipals := make([]interface{}, 0)
+		if props := item.DenyAssignmentProperties; props != nil {
+			if props.DenyAssignmentName != nil {
+				name = *props.DenyAssignmentName
+			}
+			if props.Description != nil {
+				description = *props.Description
+			}
+			if props.Scope != nil {
+				scope = *props.Scope
+			}
+			if props.DoNotApplyToChildScopes != nil {
+				applyToChildScopes = !*props.DoNotApplyToChildScopes
+			}
+			if props.IsSystemProtected != nil {
+				isSystemProtected = *props.IsSystemProtected
+			}
+			permissions = flattenDenyAssignmentPermissions(props.Permissions)
+			principals = flattenDenyAssignmentPrincipals(props.Principals)
+			excludedPrincipals = flattenDenyAssignmentPrincipals(props.ExcludePrincipals)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                    id,
+			"name":                  name,
+			"description":           description,
+			"scope":                 scope,
+			"apply_to_child_scopes": applyToChildScopes,
+			"is_system_protected":   isSystemProtected,
+			"permissions":           permissions,
+			"principals":            principals,
+			"excluded_principals":   excludedPrincipals,
+		})
+	}
+
+	return results
+}
+
+func flattenDenyAssignmentPermissions(input *[]authorization.DenyAssignmentPermission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(item.Actions),
+			"not_actions":      utils.FlattenStringSlice(item.NotActions),
+			"data_actions":     utils.FlattenStringSlice(item.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(item.NotDataActions),
+		})
+	}
+
+	return results
+}
+
+func flattenDenyAssignmentPrincipals(input *[]authorization.Principal) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+		principalType := ""
+		if item.Type != nil {
+			principalType = *item.Type
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":   id,
+			"type": principalType,
+		})
+	}
+
+	return results
+}
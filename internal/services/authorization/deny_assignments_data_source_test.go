@@ -0,0 +1,73 @@
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DenyAssignmentsDataSource struct{}
+
+func TestAccDenyAssignmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_deny_assignments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DenyAssignmentsDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deny_assignments.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDenyAssignmentsDataSource_principalId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_deny_assignments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DenyAssignmentsDataSource{}.principalId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deny_assignments.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (DenyAssignmentsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_deny_assignments" "test" {
+  scope = data.azurerm_subscription.primary.id
+}
+`
+}
+
+func (DenyAssignmentsDataSource) principalId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+data "azurerm_client_config" "test" {
+}
+
+data "azurerm_deny_assignments" "test" {
+  scope        = azurerm_resource_group.test.id
+  principal_id = data.azurerm_client_config.test.object_id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
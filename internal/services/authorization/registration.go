@@ -21,8 +21,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_client_config":   dataSourceArmClientConfig(),
-		"azurerm_role_definition": dataSourceArmRoleDefinition(),
+		"azurerm_client_config":    dataSourceArmClientConfig(),
+		"azurerm_deny_assignments": dataSourceArmDenyAssignments(),
+		"azurerm_role_definition":  dataSourceArmRoleDefinition(),
 	}
 }
 
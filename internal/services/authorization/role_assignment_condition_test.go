@@ -0,0 +1,40 @@
+package authorization
+
+import "testing"
+
+func TestNormalizeRoleAssignmentCondition(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEqualsIgnoreCase 'foo'",
+			Expected: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName]StringEqualsIgnoreCase 'foo'",
+		},
+		{
+			Input:    "  @Resource[ Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName ]\n\tStringEqualsIgnoreCase   'foo'\n",
+			Expected: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName]StringEqualsIgnoreCase 'foo'",
+		},
+		{
+			Input:    "(\n  (\n    !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})\n  )\n  OR\n  (\n    @Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'foo'\n  )\n)",
+			Expected: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'}))OR(@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName]StringEquals 'foo'))",
+		},
+		{
+			Input:    "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'two  spaces ( kept'",
+			Expected: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName]StringEquals 'two  spaces ( kept'",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := normalizeRoleAssignmentCondition(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -112,16 +114,17 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 			},
 
 			"condition": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				RequiredWith: []string{"condition_version"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.RoleAssignmentCondition,
+				DiffSuppressFunc: roleAssignmentConditionDiffSuppress,
 			},
 
 			"condition_version": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				RequiredWith: []string{"condition"},
 				ValidateFunc: validation.StringInSlice([]string{
@@ -209,11 +212,13 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	condition := d.Get("condition").(string)
 	conditionVersion := d.Get("condition_version").(string)
 
-	if condition != "" && conditionVersion != "" {
+	if condition != "" {
+		// version 2.0 is a superset of version 1.0 and is the version used by the Portal
+		if conditionVersion == "" {
+			conditionVersion = "2.0"
+		}
 		properties.RoleAssignmentProperties.Condition = utils.String(condition)
 		properties.RoleAssignmentProperties.ConditionVersion = utils.String(conditionVersion)
-	} else if condition != "" || conditionVersion != "" {
-		return fmt.Errorf("`condition` and `conditionVersion` should be both set or unset")
 	}
 
 	skipPrincipalCheck := d.Get("skip_service_principal_aad_check").(bool)
@@ -401,3 +406,44 @@ func getTenantIdBySubscriptionId(ctx context.Context, client *subscriptions.Clie
 	}
 	return *resp.TenantID, nil
 }
+
+// roleAssignmentConditionDiffSuppress ignores differences in whitespace outside of string literals, since
+// conditions are frequently written over multiple lines and the API doesn't preserve the original formatting
+func roleAssignmentConditionDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return normalizeRoleAssignmentCondition(old) == normalizeRoleAssignmentCondition(new)
+}
+
+func normalizeRoleAssignmentCondition(input string) string {
+	var sb strings.Builder
+	var previous rune
+	inString := false
+	pendingSpace := false
+	for _, c := range strings.TrimSpace(input) {
+		if inString {
+			sb.WriteRune(c)
+			if c == '\'' {
+				inString = false
+			}
+			continue
+		}
+
+		if unicode.IsSpace(c) {
+			pendingSpace = true
+			continue
+		}
+
+		// whitespace adjacent to brackets is insignificant
+		if pendingSpace && !strings.ContainsRune("()[]{}", c) && !strings.ContainsRune("()[]{}", previous) {
+			sb.WriteRune(' ')
+		}
+		pendingSpace = false
+
+		if c == '\'' {
+			inString = true
+		}
+		sb.WriteRune(c)
+		previous = c
+	}
+
+	return sb.String()
+}
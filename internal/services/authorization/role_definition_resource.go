@@ -7,13 +7,17 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -59,6 +63,12 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.Any(
+					commonids.ValidateManagementGroupID,
+					commonids.ValidateSubscriptionID,
+					commonids.ValidateResourceGroupID,
+					azure.ValidateResourceID,
+				),
 			},
 
 			"description": {
@@ -76,21 +86,24 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 						},
 						"not_actions": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 						},
 						"data_actions": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 							Set: pluginsdk.HashString,
 						},
@@ -98,7 +111,8 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 							Set: pluginsdk.HashString,
 						},
@@ -112,6 +126,12 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						commonids.ValidateManagementGroupID,
+						commonids.ValidateSubscriptionID,
+						commonids.ValidateResourceGroupID,
+						azure.ValidateResourceID,
+					),
 				},
 			},
 
@@ -120,7 +140,50 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(roleDefinitionCustomizeDiff),
+	}
+}
+
+func roleDefinitionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("scope") || !d.NewValueKnown("assignable_scopes") {
+		return nil
+	}
+
+	// when no `assignable_scopes` are specified the Role Definition is assignable at the `scope` it's created at
+	assignableScopes := []interface{}{d.Get("scope")}
+	if v := d.Get("assignable_scopes").([]interface{}); len(v) > 0 {
+		assignableScopes = v
+	}
+
+	managementGroupScopes := 0
+	for _, scope := range assignableScopes {
+		if scope == nil || scope.(string) == "" {
+			continue
+		}
+		if _, err := commonids.ParseManagementGroupIDInsensitively(scope.(string)); err == nil {
+			managementGroupScopes++
+		}
 	}
+
+	if managementGroupScopes == 0 {
+		return nil
+	}
+	if managementGroupScopes > 1 {
+		return fmt.Errorf("only one Management Group can be specified within `assignable_scopes`")
+	}
+
+	for _, raw := range d.Get("permissions").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		permission := raw.(map[string]interface{})
+		if permission["data_actions"].(*pluginsdk.Set).Len() > 0 || permission["not_data_actions"].(*pluginsdk.Set).Len() > 0 {
+			return fmt.Errorf("`data_actions` and `not_data_actions` cannot be specified when the Role Definition is assignable at a Management Group")
+		}
+	}
+
+	return nil
 }
 
 func resourceArmRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
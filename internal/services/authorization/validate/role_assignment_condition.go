@@ -0,0 +1,72 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RoleAssignmentCondition validates the structure of an Attribute Based Access Control (ABAC) condition, that is
+// string literals must be terminated, brackets must be balanced and attributes must reference a known source
+func RoleAssignmentCondition(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return
+	}
+
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	stack := make([]rune, 0)
+	inString := false
+	for i, c := range v {
+		if inString {
+			if c == '\'' {
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '\'':
+			inString = true
+		case '(', '[', '{':
+			stack = append(stack, c)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closing[c] {
+				errors = append(errors, fmt.Errorf("%q contains an unexpected %q at position %d", k, c, i))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if inString {
+		errors = append(errors, fmt.Errorf("%q contains an unterminated string literal", k))
+		return
+	}
+	if len(stack) > 0 {
+		errors = append(errors, fmt.Errorf("%q contains an unclosed %q", k, stack[len(stack)-1]))
+		return
+	}
+
+	attributes := regexp.MustCompile(`@(\w+)\[`).FindAllStringSubmatch(v, -1)
+	if len(attributes) == 0 {
+		errors = append(errors, fmt.Errorf("%q must reference at least one attribute, for example `@Resource[...]`", k))
+		return
+	}
+
+	for _, attribute := range attributes {
+		switch strings.ToLower(attribute[1]) {
+		case "environment", "principal", "request", "resource":
+		default:
+			errors = append(errors, fmt.Errorf("%q references the unsupported attribute source %q, supported sources are `@Environment`, `@Principal`, `@Request` and `@Resource`", k, attribute[1]))
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,69 @@
+package validate
+
+import "testing"
+
+func TestRoleAssignmentCondition(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEqualsIgnoreCase 'foo_storage_container'",
+			Valid: true,
+		},
+		{
+			Input: `(
+  (
+    !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})
+  )
+  OR
+  (
+    @Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>] StringEquals 'Cascade'
+  )
+)`,
+			Valid: true,
+		},
+		{
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'it''s (not) [closed'",
+			Valid: true,
+		},
+		{
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName StringEquals 'foo'",
+			Valid: false,
+		},
+		{
+			Input: "(@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'foo'",
+			Valid: false,
+		},
+		{
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'foo",
+			Valid: false,
+		},
+		{
+			Input: "(@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'foo'])",
+			Valid: false,
+		},
+		{
+			Input: "ContainerName StringEquals 'foo'",
+			Valid: false,
+		},
+		{
+			Input: "@Subject[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEquals 'foo'",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := RoleAssignmentCondition(tc.Input, "condition")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
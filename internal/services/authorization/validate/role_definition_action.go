@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// RoleDefinitionAction validates that an Action or Data Action is in the format `{Namespace}/{ResourceType}/{Operation}`,
+// for example `Microsoft.Storage/storageAccounts/read`, where any segment can be a wildcard (`*`)
+func RoleDefinitionAction(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^(\*|[\w*-]+(\.[\w*-]+)+)(/[^/\s]+)*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be `*` or in the format `{Namespace}/{ResourceType}/{Operation}` (for example `Microsoft.Storage/storageAccounts/read`), got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,73 @@
+package validate
+
+import "testing"
+
+func TestRoleDefinitionAction(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "*",
+			Valid: true,
+		},
+		{
+			Input: "*/read",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Support/*",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Resources/subscriptions/resourceGroups/read",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags/read",
+			Valid: true,
+		},
+		{
+			Input: "microsoft.insights/alertRules/*",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Compute/virtualMachines/start/action",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft/storageAccounts/read",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage//read",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts/read/",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage/storage Accounts/read",
+			Valid: false,
+		},
+		{
+			Input: "/Microsoft.Storage/storageAccounts/read",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := RoleDefinitionAction(tc.Input, "actions")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
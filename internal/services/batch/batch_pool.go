@@ -760,3 +760,150 @@ func flattenBatchPoolNetworkConfiguration(input *batch.NetworkConfiguration) []i
 		},
 	}
 }
+
+func expandBatchPoolMountConfigurations(list []interface{}) *[]batch.MountConfiguration {
+	if len(list) == 0 {
+		return nil
+	}
+
+	result := make([]batch.MountConfiguration, 0)
+	for _, item := range list {
+		if item == nil {
+			continue
+		}
+		mount := item.(map[string]interface{})
+
+		if v := mount["azure_blob_file_system"].([]interface{}); len(v) > 0 && v[0] != nil {
+			raw := v[0].(map[string]interface{})
+			config := &batch.AzureBlobFileSystemConfiguration{
+				AccountName:       utils.String(raw["account_name"].(string)),
+				ContainerName:     utils.String(raw["container_name"].(string)),
+				RelativeMountPath: utils.String(raw["relative_mount_path"].(string)),
+			}
+			if accountKey := raw["account_key"].(string); accountKey != "" {
+				config.AccountKey = utils.String(accountKey)
+			}
+			if sasKey := raw["sas_key"].(string); sasKey != "" {
+				config.SasKey = utils.String(sasKey)
+			}
+			if identityId := raw["identity_id"].(string); identityId != "" {
+				config.IdentityReference = &batch.ComputeNodeIdentityReference{
+					ResourceID: utils.String(identityId),
+				}
+			}
+			if blobfuseOptions := raw["blobfuse_options"].(string); blobfuseOptions != "" {
+				config.BlobfuseOptions = utils.String(blobfuseOptions)
+			}
+			result = append(result, batch.MountConfiguration{AzureBlobFileSystemConfiguration: config})
+		}
+
+		if v := mount["azure_file_share"].([]interface{}); len(v) > 0 && v[0] != nil {
+			raw := v[0].(map[string]interface{})
+			config := &batch.AzureFileShareConfiguration{
+				AccountName:       utils.String(raw["account_name"].(string)),
+				AzureFileURL:      utils.String(raw["azure_file_url"].(string)),
+				AccountKey:        utils.String(raw["account_key"].(string)),
+				RelativeMountPath: utils.String(raw["relative_mount_path"].(string)),
+			}
+			if mountOptions := raw["mount_options"].(string); mountOptions != "" {
+				config.MountOptions = utils.String(mountOptions)
+			}
+			result = append(result, batch.MountConfiguration{AzureFileShareConfiguration: config})
+		}
+
+		if v := mount["cifs_mount"].([]interface{}); len(v) > 0 && v[0] != nil {
+			raw := v[0].(map[string]interface{})
+			config := &batch.CIFSMountConfiguration{
+				Username:          utils.String(raw["user_name"].(string)),
+				Password:          utils.String(raw["password"].(string)),
+				Source:            utils.String(raw["source"].(string)),
+				RelativeMountPath: utils.String(raw["relative_mount_path"].(string)),
+			}
+			if mountOptions := raw["mount_options"].(string); mountOptions != "" {
+				config.MountOptions = utils.String(mountOptions)
+			}
+			result = append(result, batch.MountConfiguration{CifsMountConfiguration: config})
+		}
+
+		if v := mount["nfs_mount"].([]interface{}); len(v) > 0 && v[0] != nil {
+			raw := v[0].(map[string]interface{})
+			config := &batch.NFSMountConfiguration{
+				Source:            utils.String(raw["source"].(string)),
+				RelativeMountPath: utils.String(raw["relative_mount_path"].(string)),
+			}
+			if mountOptions := raw["mount_options"].(string); mountOptions != "" {
+				config.MountOptions = utils.String(mountOptions)
+			}
+			result = append(result, batch.MountConfiguration{NfsMountConfiguration: config})
+		}
+	}
+
+	return &result
+}
+
+func flattenBatchPoolMountConfigurations(d *pluginsdk.ResourceData, input *[]batch.MountConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for i, item := range *input {
+		azureBlobFileSystem := make([]interface{}, 0)
+		if config := item.AzureBlobFileSystemConfiguration; config != nil {
+			identityId := ""
+			if config.IdentityReference != nil && config.IdentityReference.ResourceID != nil {
+				identityId = *config.IdentityReference.ResourceID
+			}
+			// the account and sas keys aren't returned by the API, so we pull them from the config
+			azureBlobFileSystem = append(azureBlobFileSystem, map[string]interface{}{
+				"account_name":        utils.NormalizeNilableString(config.AccountName),
+				"container_name":      utils.NormalizeNilableString(config.ContainerName),
+				"relative_mount_path": utils.NormalizeNilableString(config.RelativeMountPath),
+				"account_key":         d.Get(fmt.Sprintf("mount.%d.azure_blob_file_system.0.account_key", i)).(string),
+				"sas_key":             d.Get(fmt.Sprintf("mount.%d.azure_blob_file_system.0.sas_key", i)).(string),
+				"identity_id":         identityId,
+				"blobfuse_options":    utils.NormalizeNilableString(config.BlobfuseOptions),
+			})
+		}
+
+		azureFileShare := make([]interface{}, 0)
+		if config := item.AzureFileShareConfiguration; config != nil {
+			azureFileShare = append(azureFileShare, map[string]interface{}{
+				"account_name":        utils.NormalizeNilableString(config.AccountName),
+				"azure_file_url":      utils.NormalizeNilableString(config.AzureFileURL),
+				"account_key":         d.Get(fmt.Sprintf("mount.%d.azure_file_share.0.account_key", i)).(string),
+				"relative_mount_path": utils.NormalizeNilableString(config.RelativeMountPath),
+				"mount_options":       utils.NormalizeNilableString(config.MountOptions),
+			})
+		}
+
+		cifsMount := make([]interface{}, 0)
+		if config := item.CifsMountConfiguration; config != nil {
+			cifsMount = append(cifsMount, map[string]interface{}{
+				"user_name":           utils.NormalizeNilableString(config.Username),
+				"password":            d.Get(fmt.Sprintf("mount.%d.cifs_mount.0.password", i)).(string),
+				"source":              utils.NormalizeNilableString(config.Source),
+				"relative_mount_path": utils.NormalizeNilableString(config.RelativeMountPath),
+				"mount_options":       utils.NormalizeNilableString(config.MountOptions),
+			})
+		}
+
+		nfsMount := make([]interface{}, 0)
+		if config := item.NfsMountConfiguration; config != nil {
+			nfsMount = append(nfsMount, map[string]interface{}{
+				"source":              utils.NormalizeNilableString(config.Source),
+				"relative_mount_path": utils.NormalizeNilableString(config.RelativeMountPath),
+				"mount_options":       utils.NormalizeNilableString(config.MountOptions),
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"azure_blob_file_system": azureBlobFileSystem,
+			"azure_file_share":       azureFileShare,
+			"cifs_mount":             cifsMount,
+			"nfs_mount":              nfsMount,
+		})
+	}
+
+	return results
+}
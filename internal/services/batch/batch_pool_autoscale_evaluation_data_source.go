@@ -0,0 +1,172 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	batchDataplane "github.com/Azure/azure-sdk-for-go/services/batch/2020-03-01.11.0/batch"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceBatchPoolAutoScaleEvaluation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceBatchPoolAutoScaleEvaluationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"pool_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.PoolName,
+			},
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AccountName,
+			},
+			"formula": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"results": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+			"timestamp": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"error": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBatchPoolAutoScaleEvaluationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), d.Get("pool_name").(string))
+
+	client, err := meta.(*clients.Client).Batch.PoolDataPlaneClient(ctx, parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName))
+	if err != nil {
+		return err
+	}
+
+	// the formula is validated and evaluated against the pool, but isn't applied to it
+	parameters := batchDataplane.PoolEvaluateAutoScaleParameter{
+		AutoScaleFormula: utils.String(d.Get("formula").(string)),
+	}
+	resp, err := client.EvaluateAutoScale(ctx, id.Name, parameters, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("evaluating the auto scale formula for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("pool_name", id.Name)
+	d.Set("account_name", id.BatchAccountName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("results", flattenBatchPoolAutoScaleEvaluationResults(resp.Results)); err != nil {
+		return fmt.Errorf("setting `results`: %+v", err)
+	}
+
+	timestamp := ""
+	if resp.Timestamp != nil {
+		timestamp = resp.Timestamp.Format(time.RFC3339)
+	}
+	d.Set("timestamp", timestamp)
+
+	if err := d.Set("error", flattenBatchPoolAutoScaleEvaluationError(resp.Error)); err != nil {
+		return fmt.Errorf("setting `error`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenBatchPoolAutoScaleEvaluationResults parses the results, which are returned in the form `$variable=value`
+// separated by semicolons, into a map of variable name to value
+func flattenBatchPoolAutoScaleEvaluationResults(input *string) map[string]interface{} {
+	results := make(map[string]interface{})
+	if input == nil {
+		return results
+	}
+
+	for _, item := range strings.Split(*input, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.SplitN(item, "=", 2)
+		value := ""
+		if len(parts) == 2 {
+			value = parts[1]
+		}
+		results[strings.TrimPrefix(parts[0], "$")] = value
+	}
+
+	return results
+}
+
+func flattenBatchPoolAutoScaleEvaluationError(input *batchDataplane.AutoScaleRunError) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	values := make(map[string]interface{})
+	if input.Values != nil {
+		for _, item := range *input.Values {
+			if item.Name != nil {
+				values[*item.Name] = utils.NormalizeNilableString(item.Value)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code":    utils.NormalizeNilableString(input.Code),
+			"message": utils.NormalizeNilableString(input.Message),
+			"values":  values,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package batch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type BatchPoolAutoScaleEvaluationDataSource struct {
+}
+
+func TestAccBatchPoolAutoScaleEvaluationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_batch_pool_autoscale_evaluation", "test")
+	r := BatchPoolAutoScaleEvaluationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.TargetDedicatedNodes").HasValue("2"),
+				check.That(data.ResourceName).Key("timestamp").Exists(),
+				check.That(data.ResourceName).Key("error.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (BatchPoolAutoScaleEvaluationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-batch-%d"
+  location = "%s"
+}
+
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.ubuntu 18.04"
+  vm_size             = "Standard_A1"
+
+  auto_scale {
+    evaluation_interval = "PT15M"
+    formula             = "$TargetDedicatedNodes = 1;"
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "18.04-lts"
+    version   = "latest"
+  }
+}
+
+data "azurerm_batch_pool_autoscale_evaluation" "test" {
+  pool_name           = azurerm_batch_pool.test.name
+  account_name        = azurerm_batch_pool.test.account_name
+  resource_group_name = azurerm_batch_pool.test.resource_group_name
+  formula             = "$TargetDedicatedNodes = 2;"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
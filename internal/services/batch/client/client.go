@@ -62,3 +62,23 @@ func (r *Client) JobClient(ctx context.Context, accountId parse.AccountId) (*bat
 	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
 	return &c, nil
 }
+
+func (r *Client) PoolDataPlaneClient(ctx context.Context, accountId parse.AccountId) (*batchDataplane.PoolClient, error) {
+	// Retrieve the batch account to find the batch account endpoint
+	accountClient := r.AccountClient
+	account, err := accountClient.Get(ctx, accountId.ResourceGroup, accountId.BatchAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", accountId, err)
+	}
+	if account.AccountProperties == nil {
+		return nil, fmt.Errorf(`unexpected nil of "AccountProperties" of %s`, accountId)
+	}
+	if account.AccountProperties.AccountEndpoint == nil {
+		return nil, fmt.Errorf(`unexpected nil of "AccountProperties.AccountEndpoint" of %s`, accountId)
+	}
+
+	endpoint := "https://" + *account.AccountProperties.AccountEndpoint
+	c := batchDataplane.NewPoolClient(endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
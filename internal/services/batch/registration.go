@@ -22,10 +22,11 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_batch_account":     dataSourceBatchAccount(),
-		"azurerm_batch_application": dataSourceBatchApplication(),
-		"azurerm_batch_certificate": dataSourceBatchCertificate(),
-		"azurerm_batch_pool":        dataSourceBatchPool(),
+		"azurerm_batch_account":                   dataSourceBatchAccount(),
+		"azurerm_batch_application":               dataSourceBatchApplication(),
+		"azurerm_batch_certificate":               dataSourceBatchCertificate(),
+		"azurerm_batch_pool":                      dataSourceBatchPool(),
+		"azurerm_batch_pool_autoscale_evaluation": dataSourceBatchPoolAutoScaleEvaluation(),
 	}
 }
 
@@ -1,9 +1,12 @@
 package consumption
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -12,6 +15,31 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// the API supports Forecasted thresholds, however the SDK only defines `Actual`
+const thresholdTypeForecasted = "Forecasted"
+
+// consumptionBudgetCustomizeDiff only recreates the Budget when the start date is moved backwards,
+// rolling it forward (e.g. at the start of a new fiscal year) can be done in-place
+func consumptionBudgetCustomizeDiff() pluginsdk.CustomizeDiffFunc {
+	return pluginsdk.ForceNewIfChange("time_period.0.start_date", func(ctx context.Context, old, new, _ interface{}) bool {
+		if old.(string) == "" || new.(string) == "" {
+			return false
+		}
+
+		oldStartDate, err := date.ParseTime(time.RFC3339, old.(string))
+		if err != nil {
+			return true
+		}
+
+		newStartDate, err := date.ParseTime(time.RFC3339, new.(string))
+		if err != nil {
+			return true
+		}
+
+		return newStartDate.Before(oldStartDate)
+	})
+}
+
 func resourceArmConsumptionBudgetRead(d *pluginsdk.ResourceData, meta interface{}, scope, name string) error {
 	client := meta.(*clients.Client).Consumption.BudgetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -27,6 +27,8 @@ func resourceArmConsumptionBudgetResourceGroup() *pluginsdk.Resource {
 		},
 
 		Schema: SchemaConsumptionBudgetResourceGroupResource(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(consumptionBudgetCustomizeDiff()),
 	}
 }
 
@@ -28,6 +28,8 @@ func resourceArmConsumptionBudgetSubscription() *pluginsdk.Resource {
 		},
 
 		Schema: SchemaConsumptionBudgetSubscriptionResource(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(consumptionBudgetCustomizeDiff()),
 	}
 }
 
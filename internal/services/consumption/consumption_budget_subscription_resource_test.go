@@ -114,6 +114,29 @@ func TestAccConsumptionBudgetSubscription_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccConsumptionBudgetSubscription_updateStartDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_subscription", "test")
+	r := ConsumptionBudgetSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.startDate(data, consumptionBudgetTestStartDate(), "Actual"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// rolling the start date forward and toggling the threshold type are both updated in-place
+			Config: r.startDate(data, consumptionBudgetTestStartDate().AddDate(0, 1, 0), "Forecasted"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ConsumptionBudgetSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ConsumptionBudgetSubscriptionID(state.ID)
 	if err != nil {
@@ -440,3 +463,36 @@ resource "azurerm_consumption_budget_subscription" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
 }
+
+func (ConsumptionBudgetSubscriptionResource) startDate(data acceptance.TestData, startDate time.Time, thresholdType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_consumption_budget_subscription" "test" {
+  name            = "acctestconsumptionbudgetsubscription-%d"
+  subscription_id = data.azurerm_subscription.current.subscription_id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 90.0
+    operator       = "GreaterThan"
+    threshold_type = "%s"
+
+    contact_emails = [
+      "foo@example.com",
+    ]
+  }
+}
+`, data.RandomInteger, startDate.Format(time.RFC3339), thresholdType)
+}
@@ -2,6 +2,7 @@ package consumption
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
@@ -86,7 +87,9 @@ func ExpandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 			notification.ContactRoles = utils.ExpandStringSlice(notificationRaw["contact_roles"].([]interface{}))
 			notification.ContactGroups = utils.ExpandStringSlice(notificationRaw["contact_groups"].([]interface{}))
 
-			notificationKey := fmt.Sprintf("actual_%s_%s_Percent", string(notification.Operator), notification.Threshold.StringFixed(0))
+			// the key includes the threshold type so that Actual and Forecasted notifications for the same
+			// threshold don't collide, and so that toggling the threshold type replaces the notification
+			notificationKey := fmt.Sprintf("%s_%s_%s_Percent", strings.ToLower(string(notification.ThresholdType)), string(notification.Operator), notification.Threshold.StringFixed(0))
 			notifications[notificationKey] = &notification
 		}
 	}
@@ -262,7 +265,9 @@ func FlattenConsumptionBudgetFilter(input *consumption.BudgetFilter) []interface
 		for _, v := range *input.And {
 			if v.Dimensions != nil {
 				dimensions = append(dimensions, FlattenConsumptionBudgetComparisonExpression(v.Dimensions))
-			} else {
+			}
+
+			if v.Tags != nil {
 				tags = append(tags, FlattenConsumptionBudgetComparisonExpression(v.Tags))
 			}
 		}
@@ -104,6 +104,7 @@ func SchemaConsumptionBudgetFilterTagElement() *pluginsdk.Resource {
 			},
 			"values": {
 				Type:     pluginsdk.TypeList,
+				MinItems: 1,
 				Required: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
@@ -127,17 +128,13 @@ func SchemaConsumptionBudgetNotificationElement() *pluginsdk.Resource {
 				Required:     true,
 				ValidateFunc: validation.IntBetween(0, 1000),
 			},
-			// Issue: https://github.com/Azure/azure-rest-api-specs/issues/16240
-			// Toggling between these two values doesn't work at the moment and also doesn't throw an error
-			// but it seems unlikely that a user would switch the threshold_type of their budgets frequently
 			"threshold_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				Default:  string(consumption.ThresholdTypeActual),
-				ForceNew: true, // todo: remove this when the above issue is fixed
 				ValidateFunc: validation.StringInSlice([]string{
 					string(consumption.ThresholdTypeActual),
-					"Forecasted",
+					thresholdTypeForecasted,
 				}, false),
 			},
 			"operator": {
@@ -280,11 +277,12 @@ func SchemaConsumptionBudgetCommonResource() map[string]*pluginsdk.Schema {
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
+					// changes are handled in consumptionBudgetCustomizeDiff, since rolling the
+					// start date forward can be done in-place
 					"start_date": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ValidateFunc: validate.ConsumptionBudgetTimePeriodStartDate,
-						ForceNew:     true,
 					},
 					"end_date": {
 						Type:         pluginsdk.TypeString,
@@ -15,8 +15,10 @@ type Client struct {
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
+	RegistryAgentPoolsClient        *containerregistry.AgentPoolsClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
 	ServicesClient                  *legacy.ContainerServicesClient
+	SnapshotsClient                 *containerservice.SnapshotsClient
 	WebhooksClient                  *containerregistry.WebhooksClient
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
@@ -40,6 +42,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	scopeMapsClient := containerregistry.NewScopeMapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scopeMapsClient.Client, o.ResourceManagerAuthorizer)
 
+	registryAgentPoolsClient := containerregistry.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryAgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -53,6 +58,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	snapshotsClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -62,9 +70,11 @@ func NewClient(o *common.ClientOptions) *Client {
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
 		RegistriesClient:                &registriesClient,
+		RegistryAgentPoolsClient:        &registryAgentPoolsClient,
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
 		ServicesClient:                  &servicesClient,
+		SnapshotsClient:                 &snapshotsClient,
 		Environment:                     o.Environment,
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
@@ -0,0 +1,199 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryAgentPoolResource struct{}
+
+func TestAccContainerRegistryAgentPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryAgentPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryAgentPool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryAgentPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.instanceCount(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryAgentPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryAgentPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistryAgentPoolsClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ContainerRegistryAgentPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                    = "ap%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  container_registry_name = azurerm_container_registry.test.name
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
+
+func (r ContainerRegistryAgentPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "import" {
+  name                    = azurerm_container_registry_agent_pool.test.name
+  resource_group_name     = azurerm_container_registry_agent_pool.test.resource_group_name
+  location                = azurerm_container_registry_agent_pool.test.location
+  container_registry_name = azurerm_container_registry_agent_pool.test.container_registry_name
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryAgentPoolResource) instanceCount(data acceptance.TestData, instanceCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                    = "ap%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  container_registry_name = azurerm_container_registry.test.name
+  instance_count          = %d
+}
+`, r.template(data), data.RandomIntOfLength(8), instanceCount)
+}
+
+func (r ContainerRegistryAgentPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.1.0.0/24"]
+}
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                      = "ap%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  container_registry_name   = azurerm_container_registry.test.name
+  instance_count            = 2
+  tier                      = "S2"
+  virtual_network_subnet_id = azurerm_subnet.test.id
+
+  tags = {
+    environment = "Testing"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomIntOfLength(8))
+}
+
+func (ContainerRegistryAgentPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
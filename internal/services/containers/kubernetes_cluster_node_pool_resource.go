@@ -93,9 +93,12 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			},
 
 			"enable_host_encryption": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				ForceNew: true,
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"host_encryption_enabled"},
+				Deprecated:    "Deprecated in favour of `host_encryption_enabled`", // TODO -- remove this in next major version
 			},
 
 			"enable_node_public_ip": {
@@ -114,6 +117,14 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"host_encryption_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true, // TODO -- remove this when deprecation resolves
+				ConflictsWith: []string{"enable_host_encryption"},
+			},
+
 			"kubelet_config": schemaNodePoolKubeletConfig(),
 
 			"linux_os_config": schemaNodePoolLinuxOSConfig(),
@@ -264,6 +275,23 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.ProximityPlacementGroupID,
 			},
 
+			"scale_down_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(containerservice.ScaleDownModeDelete),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.ScaleDownModeDelete),
+					string(containerservice.ScaleDownModeDeallocate),
+				}, false),
+			},
+
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.NodePoolSnapshotID,
+			},
+
 			"spot_max_price": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -353,7 +381,13 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	spotMaxPrice := d.Get("spot_max_price").(float64)
 	t := d.Get("tags").(map[string]interface{})
 	vmSize := d.Get("vm_size").(string)
-	enableHostEncryption := d.Get("enable_host_encryption").(bool)
+	enableHostEncryption := false
+	if v, ok := d.GetOk("enable_host_encryption"); ok {
+		enableHostEncryption = v.(bool)
+	}
+	if v, ok := d.GetOk("host_encryption_enabled"); ok {
+		enableHostEncryption = v.(bool)
+	}
 
 	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
 		OsType:                 containerservice.OSType(osType),
@@ -363,6 +397,7 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
 		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
 		Mode:                   mode,
+		ScaleDownMode:          containerservice.ScaleDownMode(d.Get("scale_down_mode").(string)),
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
 		Tags:                   tags.Expand(t),
 		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
@@ -444,6 +479,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	maxCount := d.Get("max_count").(int)
 	minCount := d.Get("min_count").(int)
 
@@ -559,6 +600,10 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.EnableEncryptionAtHost = utils.Bool(d.Get("enable_host_encryption").(bool))
 	}
 
+	if d.HasChange("host_encryption_enabled") {
+		props.EnableEncryptionAtHost = utils.Bool(d.Get("host_encryption_enabled").(bool))
+	}
+
 	if d.HasChange("enable_node_public_ip") {
 		props.EnableNodePublicIP = utils.Bool(d.Get("enable_node_public_ip").(bool))
 	}
@@ -602,6 +647,10 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
+	if d.HasChange("scale_down_mode") {
+		props.ScaleDownMode = containerservice.ScaleDownMode(d.Get("scale_down_mode").(string))
+	}
+
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
@@ -702,6 +751,7 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("enable_auto_scaling", props.EnableAutoScaling)
 		d.Set("enable_node_public_ip", props.EnableNodePublicIP)
 		d.Set("enable_host_encryption", props.EnableEncryptionAtHost)
+		d.Set("host_encryption_enabled", props.EnableEncryptionAtHost)
 		d.Set("fips_enabled", props.EnableFIPS)
 		d.Set("ultra_ssd_enabled", props.EnableUltraSSD)
 		d.Set("kubelet_disk_type", string(props.KubeletDiskType))
@@ -789,6 +839,23 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 		d.Set("proximity_placement_group_id", props.ProximityPlacementGroupID)
 
+		// defaults to `Delete` when not returned from the API
+		scaleDownMode := string(containerservice.ScaleDownModeDelete)
+		if props.ScaleDownMode != "" {
+			scaleDownMode = string(props.ScaleDownMode)
+		}
+		d.Set("scale_down_mode", scaleDownMode)
+
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			snapshot, err := parse.NodePoolSnapshotID(*props.CreationData.SourceResourceID)
+			if err != nil {
+				return err
+			}
+			snapshotId = snapshot.ID()
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		spotMaxPrice := -1.0
 		if props.SpotMaxPrice != nil {
 			spotMaxPrice = *props.SpotMaxPrice
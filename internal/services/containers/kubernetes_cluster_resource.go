@@ -49,6 +49,13 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// without a Private DNS Zone the API Server can only be reached using the public FQDN
+				if d.Get("private_dns_zone_id").(string) == "None" && !d.Get("private_cluster_public_fqdn_enabled").(bool) {
+					return fmt.Errorf("`private_cluster_public_fqdn_enabled` must be set to `true` when `private_dns_zone_id` is set to `None`")
+				}
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -1376,10 +1383,13 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		if v, ok := d.GetOk("private_cluster_enabled"); ok {
 			enablePrivateCluster = v.(bool)
 		}
-		existing.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
-			AuthorizedIPRanges:   utils.ExpandStringSlice(apiServerAuthorizedIPRangesRaw),
-			EnablePrivateCluster: &enablePrivateCluster,
+
+		// the other properties of the access profile (e.g. the public FQDN) must be retained, so only update the delta
+		if existing.ManagedClusterProperties.APIServerAccessProfile == nil {
+			existing.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{}
 		}
+		existing.ManagedClusterProperties.APIServerAccessProfile.AuthorizedIPRanges = utils.ExpandStringSlice(apiServerAuthorizedIPRangesRaw)
+		existing.ManagedClusterProperties.APIServerAccessProfile.EnablePrivateCluster = &enablePrivateCluster
 		if v, ok := d.GetOk("private_dns_zone_id"); ok {
 			existing.ManagedClusterProperties.APIServerAccessProfile.PrivateDNSZone = utils.String(v.(string))
 		}
@@ -1387,7 +1397,11 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	if d.HasChange("private_cluster_public_fqdn_enabled") {
 		updateCluster = true
-		existing.ManagedClusterProperties.APIServerAccessProfile.EnablePrivateClusterPublicFQDN = utils.Bool(d.Get("private_cluster_public_fqdn_enabled").(bool))
+		privateClusterPublicFqdnEnabled := d.Get("private_cluster_public_fqdn_enabled").(bool)
+		if existing.ManagedClusterProperties.APIServerAccessProfile == nil {
+			existing.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{}
+		}
+		existing.ManagedClusterProperties.APIServerAccessProfile.EnablePrivateClusterPublicFQDN = utils.Bool(privateClusterPublicFqdnEnabled)
 	}
 
 	if d.HasChange("auto_scaler_profile") {
@@ -0,0 +1,185 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKubernetesNodePoolSnapshot() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesNodePoolSnapshotCreate,
+		Read:   resourceKubernetesNodePoolSnapshotRead,
+		Update: resourceKubernetesNodePoolSnapshotUpdate,
+		Delete: resourceKubernetesNodePoolSnapshotDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NodePoolSnapshotID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.KubernetesNodePoolSnapshotName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"source_node_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.NodePoolID,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKubernetesNodePoolSnapshotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.SnapshotsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNodePoolSnapshotID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.SnapshotName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_kubernetes_node_pool_snapshot", id.ID())
+	}
+
+	nodePoolId, err := parse.NodePoolID(d.Get("source_node_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := containerservice.Snapshot{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		SnapshotProperties: &containerservice.SnapshotProperties{
+			CreationData: &containerservice.CreationData{
+				SourceResourceID: utils.String(nodePoolId.ID()),
+			},
+			SnapshotType: containerservice.SnapshotTypeNodePool,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SnapshotName, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKubernetesNodePoolSnapshotRead(d, meta)
+}
+
+func resourceKubernetesNodePoolSnapshotUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.SnapshotsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NodePoolSnapshotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := containerservice.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+
+		if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.SnapshotName, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceKubernetesNodePoolSnapshotRead(d, meta)
+}
+
+func resourceKubernetesNodePoolSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.SnapshotsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NodePoolSnapshotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.SnapshotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SnapshotName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	sourceNodePoolId := ""
+	if props := resp.SnapshotProperties; props != nil {
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			nodePoolId, err := parse.NodePoolID(*props.CreationData.SourceResourceID)
+			if err != nil {
+				return err
+			}
+			sourceNodePoolId = nodePoolId.ID()
+		}
+	}
+	d.Set("source_node_pool_id", sourceNodePoolId)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKubernetesNodePoolSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.SnapshotsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NodePoolSnapshotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.SnapshotName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesNodePoolSnapshotResource struct{}
+
+func TestAccKubernetesNodePoolSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_node_pool_snapshot", "test")
+	r := KubernetesNodePoolSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesNodePoolSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_node_pool_snapshot", "test")
+	r := KubernetesNodePoolSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesNodePoolSnapshot_tags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_node_pool_snapshot", "test")
+	r := KubernetesNodePoolSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Testing"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t KubernetesNodePoolSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NodePoolSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.SnapshotsClient.Get(ctx, id.ResourceGroup, id.SnapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r KubernetesNodePoolSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_node_pool_snapshot" "test" {
+  name                = "acctestsnapshot%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_node_pool_id = azurerm_kubernetes_cluster_node_pool.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesNodePoolSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_node_pool_snapshot" "import" {
+  name                = azurerm_kubernetes_node_pool_snapshot.test.name
+  resource_group_name = azurerm_kubernetes_node_pool_snapshot.test.resource_group_name
+  location            = azurerm_kubernetes_node_pool_snapshot.test.location
+  source_node_pool_id = azurerm_kubernetes_node_pool_snapshot.test.source_node_pool_id
+}
+`, r.basic(data))
+}
+
+func (r KubernetesNodePoolSnapshotResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_node_pool_snapshot" "test" {
+  name                = "acctestsnapshot%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_node_pool_id = azurerm_kubernetes_cluster_node_pool.test.id
+
+  tags = {
+    environment = "Testing"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (KubernetesNodePoolSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,51 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryAgentPoolName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent-pool-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent_pool",
+			ErrCount: 1,
+		},
+		{
+			Value:    "AgentPool",
+			ErrCount: 0,
+		},
+		{
+			Value:    "abcdefghijklmnopqrst",
+			ErrCount: 0,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstu",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryAgentPoolName(tc.Value, "azurerm_container_registry_agent_pool")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure Container Registry Agent Pool Name to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
@@ -37,3 +37,14 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func KubernetesNodePoolSnapshotName(i interface{}, k string) (warnings []string, errors []error) {
+	snapshotName := i.(string)
+
+	re := regexp.MustCompile(`^[a-zA-Z0-9]$|^[a-zA-Z0-9][-_a-zA-Z0-9]{0,61}[a-zA-Z0-9]$`)
+	if re != nil && !re.MatchString(snapshotName) {
+		errors = append(errors, fmt.Errorf("%s must contain between 1 and 63 characters. The name can contain only letters, numbers, underscores and hyphens. The name must start and end with an alphanumeric character. Got %q.", k, snapshotName))
+	}
+
+	return warnings, errors
+}
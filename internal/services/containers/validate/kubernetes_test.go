@@ -133,3 +133,41 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesNodePoolSnapshotName(t *testing.T) {
+	cases := []struct {
+		SnapshotName string
+		Errors       int
+	}{
+		{
+			SnapshotName: "",
+			Errors:       1,
+		},
+		{
+			SnapshotName: "a",
+			Errors:       0,
+		},
+		{
+			SnapshotName: "acctest-snapshot_1",
+			Errors:       0,
+		},
+		{
+			SnapshotName: "-snapshot",
+			Errors:       1,
+		},
+		{
+			SnapshotName: "snapshot-",
+			Errors:       1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.SnapshotName, func(t *testing.T) {
+			_, errors := KubernetesNodePoolSnapshotName(tc.SnapshotName, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected SnapshotName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -30,9 +31,18 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.SqlContainerV0ToV1{},
+			1: migration.SqlContainerV1ToV2{},
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// more than one path is a hierarchical (MultiHash) partition key, which requires version 2
+			if len(diff.Get("partition_key_paths").([]interface{})) > 1 && diff.Get("partition_key_version").(int) != 2 {
+				return fmt.Errorf("`partition_key_version` must be set to `2` when more than one path is specified in `partition_key_paths`")
+			}
+			return nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -68,9 +78,26 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 
 			"partition_key_path": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"partition_key_path", "partition_key_paths"},
+			},
+
+			// up to three paths can be specified to use hierarchical partition keys
+			"partition_key_paths": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				MaxItems:     3,
+				ExactlyOneOf: []string{"partition_key_path", "partition_key_paths"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
 			},
 
 			"partition_key_version": {
@@ -136,8 +163,6 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 	resourceGroup := d.Get("resource_group_name").(string)
 	database := d.Get("database_name").(string)
 	account := d.Get("account_name").(string)
-	partitionkeypaths := d.Get("partition_key_path").(string)
-
 	existing, err := client.GetSQLContainer(ctx, resourceGroup, account, database, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
@@ -168,16 +193,7 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
-	if partitionkeypaths != "" {
-		db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = &documentdb.ContainerPartitionKey{
-			Paths: &[]string{partitionkeypaths},
-			Kind:  documentdb.PartitionKindHash,
-		}
-
-		if partitionKeyVersion, ok := d.GetOk("partition_key_version"); ok {
-			db.SQLContainerCreateUpdateProperties.Resource.PartitionKey.Version = utils.Int32(int32(partitionKeyVersion.(int)))
-		}
-	}
+	db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = expandCosmosSQLContainerPartitionKey(d)
 
 	if keys := expandCosmosSQLContainerUniqueKeys(d.Get("unique_key").(*pluginsdk.Set)); keys != nil {
 		db.SQLContainerCreateUpdateProperties.Resource.UniqueKeyPolicy = &documentdb.UniqueKeyPolicy{
@@ -241,8 +257,6 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
 
-	partitionkeypaths := d.Get("partition_key_path").(string)
-
 	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
 	err = common.ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy)
 	if err != nil {
@@ -259,16 +273,7 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
-	if partitionkeypaths != "" {
-		db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = &documentdb.ContainerPartitionKey{
-			Paths: &[]string{partitionkeypaths},
-			Kind:  documentdb.PartitionKindHash,
-		}
-
-		if partitionKeyVersion, ok := d.GetOk("partition_key_version"); ok {
-			db.SQLContainerCreateUpdateProperties.Resource.PartitionKey.Version = utils.Int32(int32(partitionKeyVersion.(int)))
-		}
-	}
+	db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = expandCosmosSQLContainerPartitionKey(d)
 
 	if keys := expandCosmosSQLContainerUniqueKeys(d.Get("unique_key").(*pluginsdk.Set)); keys != nil {
 		db.SQLContainerCreateUpdateProperties.Resource.UniqueKeyPolicy = &documentdb.UniqueKeyPolicy{
@@ -342,11 +347,13 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 		if res := props.Resource; res != nil {
 			if pk := res.PartitionKey; pk != nil {
 				if paths := pk.Paths; paths != nil {
-					if len(*paths) > 1 {
-						return fmt.Errorf("reading PartitionKey Paths, more then 1 returned")
-					} else if len(*paths) == 1 {
-						d.Set("partition_key_path", (*paths)[0])
+					// `partition_key_path` can only represent a single (non-hierarchical) partition key
+					partitionKeyPath := ""
+					if len(*paths) == 1 {
+						partitionKeyPath = (*paths)[0]
 					}
+					d.Set("partition_key_path", partitionKeyPath)
+					d.Set("partition_key_paths", utils.FlattenStringSlice(paths))
 				}
 				if version := pk.Version; version != nil {
 					d.Set("partition_key_version", version)
@@ -429,6 +436,35 @@ func resourceCosmosDbSQLContainerDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
+func expandCosmosSQLContainerPartitionKey(d *pluginsdk.ResourceData) *documentdb.ContainerPartitionKey {
+	paths := make([]string, 0)
+	if v := d.Get("partition_key_path").(string); v != "" {
+		paths = append(paths, v)
+	} else {
+		paths = *utils.ExpandStringSlice(d.Get("partition_key_paths").([]interface{}))
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	partitionKey := &documentdb.ContainerPartitionKey{
+		Paths: &paths,
+		Kind:  documentdb.PartitionKindHash,
+	}
+
+	partitionKeyVersion, hasVersion := d.GetOk("partition_key_version")
+	if len(paths) > 1 {
+		partitionKey.Kind = documentdb.PartitionKindMultiHash
+	}
+
+	if hasVersion {
+		partitionKey.Version = utils.Int32(int32(partitionKeyVersion.(int)))
+	}
+
+	return partitionKey
+}
+
 func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]documentdb.UniqueKey {
 	i := s.List()
 	if len(i) == 0 || i[0] == nil {
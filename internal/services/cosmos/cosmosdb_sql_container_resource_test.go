@@ -208,6 +208,23 @@ func TestAccCosmosDbSqlContainer_partition_key_version(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_hierarchicalPartitionKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hierarchicalPartitionKeys(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partition_key_paths.#").HasValue("3"),
+				check.That(data.ResourceName).Key("partition_key_version").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccCosmosDbSqlContainer_customConflictResolutionPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
 	r := CosmosSqlContainerResource{}
@@ -539,6 +556,21 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger, version)
 }
 
+func (CosmosSqlContainerResource) hierarchicalPartitionKeys(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                  = "acctest-CSQLC-%[2]d"
+  resource_group_name   = azurerm_cosmosdb_account.test.resource_group_name
+  account_name          = azurerm_cosmosdb_account.test.name
+  database_name         = azurerm_cosmosdb_sql_database.test.name
+  partition_key_paths   = ["/tenantId", "/userId", "/sessionId"]
+  partition_key_version = 2
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
+}
+
 func (CosmosSqlContainerResource) conflictResolutionPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -88,3 +88,225 @@ func (SqlContainerV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
 		return rawState, nil
 	}
 }
+
+var _ pluginsdk.StateUpgrade = SqlContainerV1ToV2{}
+
+type SqlContainerV1ToV2 struct{}
+
+func (SqlContainerV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"account_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"database_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"partition_key_path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"partition_key_version": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"conflict_resolution_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"conflict_resolution_path": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"conflict_resolution_procedure": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"throughput": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+
+		"autoscale_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_throughput": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"analytical_storage_ttl": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		},
+
+		"default_ttl": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+
+		"unique_key": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"paths": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"indexing_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"indexing_mode": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"included_path": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"path": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					"excluded_path": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"path": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					"composite_index": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"index": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"path": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+											},
+
+											"order": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"spatial_index": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"path": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+
+								"types": {
+									Type:     pluginsdk.TypeSet,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (SqlContainerV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// `partition_key_paths` supersedes the single `partition_key_path`
+		if v, ok := rawState["partition_key_path"].(string); ok && v != "" {
+			log.Printf("[DEBUG] Populating `partition_key_paths` from `partition_key_path` %q", v)
+			rawState["partition_key_paths"] = []interface{}{v}
+		}
+
+		return rawState, nil
+	}
+}
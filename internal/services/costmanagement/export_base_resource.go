@@ -156,7 +156,7 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 
 			resp, err := client.Get(ctx, id.Scope, id.Name, "")
 			if err != nil {
-				if !utils.ResponseWasNotFound(resp.Response) {
+				if utils.ResponseWasNotFound(resp.Response) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("reading %s: %+v", *id, err)
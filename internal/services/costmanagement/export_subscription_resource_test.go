@@ -79,6 +79,18 @@ func TestAccSubscriptionCostManagementExport_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionCostManagementExport_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_cost_management_export", "test")
+	r := SubscriptionCostManagementExport{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
 func (t SubscriptionCostManagementExport) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.CostManagementExportID(state.ID)
 	if err != nil {
@@ -93,6 +105,19 @@ func (t SubscriptionCostManagementExport) Exists(ctx context.Context, clients *c
 	return utils.Bool(resp.ExportProperties != nil), nil
 }
 
+func (t SubscriptionCostManagementExport) Destroy(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CostManagementExportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.CostManagement.ExportClient.Delete(ctx, id.Scope, id.Name); err != nil {
+		return nil, fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
 func (SubscriptionCostManagementExport) basic(data acceptance.TestData) string {
 	start := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
 	end := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
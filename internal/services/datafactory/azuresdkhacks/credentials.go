@@ -0,0 +1,135 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: the 2018-06-01 SDK contains the Credential models but doesn't contain a client for the Credential Operations
+// API, as such this is a minimal client built on top of the BaseClient which can be removed once the SDK is updated.
+
+type CredentialOperationsClient struct {
+	datafactory.BaseClient
+}
+
+// CredentialResource wraps datafactory.CredentialResource, which doesn't contain the HTTP Response
+type CredentialResource struct {
+	autorest.Response `json:"-"`
+	datafactory.CredentialResource
+}
+
+func NewCredentialOperationsClientWithBaseURI(baseURI string, subscriptionID string) CredentialOperationsClient {
+	return CredentialOperationsClient{datafactory.NewWithBaseURI(baseURI, subscriptionID)}
+}
+
+func (client CredentialOperationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, factoryName string, credentialName string, credential datafactory.CredentialResource) (result CredentialResource, err error) {
+	pathParameters := credentialPathParameters(client.SubscriptionID, resourceGroupName, factoryName, credentialName)
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, pathParameters),
+		autorest.WithJSON(credential),
+		autorest.WithQueryParameters(credentialQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client CredentialOperationsClient) Get(ctx context.Context, resourceGroupName string, factoryName string, credentialName string) (result CredentialResource, err error) {
+	pathParameters := credentialPathParameters(client.SubscriptionID, resourceGroupName, factoryName, credentialName)
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, pathParameters),
+		autorest.WithQueryParameters(credentialQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client CredentialOperationsClient) Delete(ctx context.Context, resourceGroupName string, factoryName string, credentialName string) (result autorest.Response, err error) {
+	pathParameters := credentialPathParameters(client.SubscriptionID, resourceGroupName, factoryName, credentialName)
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, pathParameters),
+		autorest.WithQueryParameters(credentialQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = resp
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datafactory.CredentialOperationsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+const credentialPath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DataFactory/factories/{factoryName}/credentials/{credentialName}"
+
+func credentialPathParameters(subscriptionId, resourceGroupName, factoryName, credentialName string) map[string]interface{} {
+	return map[string]interface{}{
+		"credentialName":    autorest.Encode("path", credentialName),
+		"factoryName":       autorest.Encode("path", factoryName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", subscriptionId),
+	}
+}
+
+func credentialQueryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": "2018-06-01",
+	}
+}
@@ -3,9 +3,11 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/azuresdkhacks"
 )
 
 type Client struct {
+	CredentialsClient             *azuresdkhacks.CredentialOperationsClient
 	DataFlowClient                *datafactory.DataFlowsClient
 	DatasetClient                 *datafactory.DatasetsClient
 	FactoriesClient               *datafactory.FactoriesClient
@@ -18,6 +20,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	CredentialsClient := azuresdkhacks.NewCredentialOperationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&CredentialsClient.Client, o.ResourceManagerAuthorizer)
+
 	dataFlowClient := datafactory.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataFlowClient.Client, o.ResourceManagerAuthorizer)
 
@@ -46,6 +51,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		CredentialsClient:             &CredentialsClient,
 		DataFlowClient:                &dataFlowClient,
 		DatasetClient:                 &DatasetClient,
 		FactoriesClient:               &FactoriesClient,
@@ -298,6 +298,25 @@ func expandAzureKeyVaultSecretReference(input []interface{}) *datafactory.AzureK
 	}
 }
 
+func expandDataFactoryCredentialReference(credentialName string) *datafactory.CredentialReference {
+	if credentialName == "" {
+		return nil
+	}
+
+	return &datafactory.CredentialReference{
+		Type:          utils.String("CredentialReference"),
+		ReferenceName: utils.String(credentialName),
+	}
+}
+
+func flattenDataFactoryCredentialReference(input *datafactory.CredentialReference) string {
+	if input == nil || input.ReferenceName == nil {
+		return ""
+	}
+
+	return *input.ReferenceName
+}
+
 func flattenAzureKeyVaultConnectionString(input map[string]interface{}) []interface{} {
 	if input == nil {
 		return nil
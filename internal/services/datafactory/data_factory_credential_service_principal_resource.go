@@ -0,0 +1,207 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryCredentialServicePrincipal() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryCredentialServicePrincipalCreateUpdate,
+		Read:   resourceDataFactoryCredentialServicePrincipalRead,
+		Update: resourceDataFactoryCredentialServicePrincipalCreateUpdate,
+		Delete: resourceDataFactoryCredentialDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CredentialID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LinkedServiceDatasetName,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"service_principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"service_principal_key": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"secret_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryCredentialServicePrincipalCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewCredentialID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_data_factory_credential_service_principal", id.ID())
+		}
+	}
+
+	credential := datafactory.ServicePrincipalCredential{
+		ServicePrincipalCredentialTypeProperties: &datafactory.ServicePrincipalCredentialTypeProperties{
+			ServicePrincipalID:  d.Get("service_principal_id").(string),
+			ServicePrincipalKey: expandAzureKeyVaultSecretReference(d.Get("service_principal_key").([]interface{})),
+			Tenant:              d.Get("tenant_id").(string),
+		},
+		Type: datafactory.TypeBasicCredentialTypeServicePrincipal,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		credential.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		credential.Annotations = &annotations
+	}
+
+	parameters := datafactory.CredentialResource{
+		Properties: credential,
+	}
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryCredentialServicePrincipalRead(d, meta)
+}
+
+func resourceDataFactoryCredentialServicePrincipalRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if resp.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	credential, ok := resp.Properties.AsServicePrincipalCredential()
+	if !ok {
+		return fmt.Errorf("classifying %s: Expected: %q", id, datafactory.TypeBasicCredentialTypeServicePrincipal)
+	}
+
+	d.Set("description", credential.Description)
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(credential.Annotations)); err != nil {
+		return fmt.Errorf("setting `annotations`: %+v", err)
+	}
+
+	if props := credential.ServicePrincipalCredentialTypeProperties; props != nil {
+		if v, ok := props.ServicePrincipalID.(string); ok {
+			d.Set("service_principal_id", v)
+		}
+		if v, ok := props.Tenant.(string); ok {
+			d.Set("tenant_id", v)
+		}
+		if err := d.Set("service_principal_key", flattenAzureKeyVaultSecretReference(props.ServicePrincipalKey)); err != nil {
+			return fmt.Errorf("setting `service_principal_key`: %+v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CredentialServicePrincipalResource struct {
+}
+
+func TestAccDataFactoryCredentialServicePrincipal_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_service_principal", "test")
+	r := CredentialServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryCredentialServicePrincipal_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_service_principal", "test")
+	r := CredentialServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryCredentialServicePrincipal_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_service_principal", "test")
+	r := CredentialServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t CredentialServicePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CredentialID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.CredentialsClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r CredentialServicePrincipalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_service_principal" "test" {
+  name                 = "acctestcred%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  service_principal_id = data.azurerm_client_config.current.client_id
+
+  service_principal_key {
+    linked_service_name = azurerm_data_factory_linked_service_key_vault.test.name
+    secret_name         = "secret"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CredentialServicePrincipalResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_service_principal" "import" {
+  name                 = azurerm_data_factory_credential_service_principal.test.name
+  data_factory_id      = azurerm_data_factory_credential_service_principal.test.data_factory_id
+  tenant_id            = azurerm_data_factory_credential_service_principal.test.tenant_id
+  service_principal_id = azurerm_data_factory_credential_service_principal.test.service_principal_id
+
+  service_principal_key {
+    linked_service_name = azurerm_data_factory_linked_service_key_vault.test.name
+    secret_name         = "secret"
+  }
+}
+`, r.basic(data))
+}
+
+func (r CredentialServicePrincipalResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_service_principal" "test" {
+  name                 = "acctestcred%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  service_principal_id = data.azurerm_client_config.current.client_id
+  description          = "test description"
+  annotations          = ["test1", "test2"]
+
+  service_principal_key {
+    linked_service_name = azurerm_data_factory_linked_service_key_vault.test.name
+    secret_name         = "secret2"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (CredentialServicePrincipalResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctkv%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_key_vault" "test" {
+  name                = "linkkv"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_id     = azurerm_data_factory.test.id
+  key_vault_id        = azurerm_key_vault.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,205 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CredentialUserManagedIdentityResource struct {
+}
+
+func TestAccDataFactoryCredentialUserManagedIdentity_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_user_managed_identity", "test")
+	r := CredentialUserManagedIdentityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryCredentialUserManagedIdentity_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_user_managed_identity", "test")
+	r := CredentialUserManagedIdentityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryCredentialUserManagedIdentity_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_user_managed_identity", "test")
+	r := CredentialUserManagedIdentityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryCredentialUserManagedIdentity_linkedService(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_user_managed_identity", "test")
+	r := CredentialUserManagedIdentityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedService(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_data_factory_linked_service_azure_blob_storage.test").Key("credential_name").HasValue(fmt.Sprintf("acctestcred%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t CredentialUserManagedIdentityResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CredentialID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.CredentialsClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r CredentialUserManagedIdentityResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_user_managed_identity" "test" {
+  name            = "acctestcred%d"
+  data_factory_id = azurerm_data_factory.test.id
+  identity_id     = azurerm_user_assigned_identity.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CredentialUserManagedIdentityResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_user_managed_identity" "import" {
+  name            = azurerm_data_factory_credential_user_managed_identity.test.name
+  data_factory_id = azurerm_data_factory_credential_user_managed_identity.test.data_factory_id
+  identity_id     = azurerm_data_factory_credential_user_managed_identity.test.identity_id
+}
+`, r.basic(data))
+}
+
+func (r CredentialUserManagedIdentityResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_credential_user_managed_identity" "test" {
+  name            = "acctestcred%d"
+  data_factory_id = azurerm_data_factory.test.id
+  identity_id     = azurerm_user_assigned_identity.test.id
+  description     = "test description"
+  annotations     = ["test1", "test2"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CredentialUserManagedIdentityResource) linkedService(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
+  name                 = "acctestlsblob%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  data_factory_id      = azurerm_data_factory.test.id
+  service_endpoint     = azurerm_storage_account.test.primary_blob_endpoint
+  use_managed_identity = true
+  credential_name      = azurerm_data_factory_credential_user_managed_identity.test.name
+}
+`, r.basic(data), data.RandomString, data.RandomInteger)
+}
+
+func (CredentialUserManagedIdentityResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
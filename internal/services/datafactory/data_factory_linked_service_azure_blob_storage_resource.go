@@ -134,6 +134,13 @@ func resourceDataFactoryLinkedServiceAzureBlobStorage() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"connection_string", "sas_uri", "service_endpoint"},
 			},
 
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"use_managed_identity"},
+			},
+
 			"service_principal_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -244,6 +251,7 @@ func resourceDataFactoryLinkedServiceBlobStorageCreateUpdate(d *pluginsdk.Resour
 		if v, ok := d.GetOk("service_endpoint"); ok {
 			blobStorageProperties.ServiceEndpoint = utils.String(v.(string))
 		}
+		blobStorageProperties.Credential = expandDataFactoryCredentialReference(d.Get("credential_name").(string))
 	} else {
 		secureString := datafactory.SecureString{
 			Value: utils.String(d.Get("service_principal_key").(string)),
@@ -339,6 +347,8 @@ func resourceDataFactoryLinkedServiceBlobStorageRead(d *pluginsdk.ResourceData,
 	}
 
 	if properties := blobStorage.AzureBlobStorageLinkedServiceTypeProperties; properties != nil {
+		d.Set("credential_name", flattenDataFactoryCredentialReference(properties.Credential))
+
 		if sasToken := properties.SasToken; sasToken != nil {
 			if keyVaultPassword, ok := sasToken.AsAzureKeyVaultSecretReference(); ok {
 				if err := d.Set("key_vault_sas_token", flattenAzureKeyVaultSecretReference(keyVaultPassword)); err != nil {
@@ -133,6 +133,13 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *pluginsdk.Resource {
 				},
 			},
 
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"use_managed_identity"},
+			},
+
 			"service_principal_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -243,6 +250,7 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate(d *pluginsdk.R
 
 	if d.Get("use_managed_identity").(bool) {
 		sqlDatabaseProperties.Tenant = utils.String(d.Get("tenant_id").(string))
+		sqlDatabaseProperties.Credential = expandDataFactoryCredentialReference(d.Get("credential_name").(string))
 	} else {
 		secureString := datafactory.SecureString{
 			Value: utils.String(d.Get("service_principal_key").(string)),
@@ -339,6 +347,10 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseRead(d *pluginsdk.ResourceD
 		} else {
 			d.Set("use_managed_identity", true)
 		}
+
+		if props := sql.AzureSQLDatabaseLinkedServiceTypeProperties; props != nil {
+			d.Set("credential_name", flattenDataFactoryCredentialReference(props.Credential))
+		}
 	}
 
 	if sql.ConnectionString != nil {
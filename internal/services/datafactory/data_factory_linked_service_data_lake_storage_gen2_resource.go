@@ -81,6 +81,13 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"use_managed_identity"},
+			},
+
 			"service_principal_id": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
@@ -193,7 +200,8 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate(d *pluginsd
 
 	if d.Get("use_managed_identity").(bool) {
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
-			URL: utils.String(d.Get("url").(string)),
+			URL:        utils.String(d.Get("url").(string)),
+			Credential: expandDataFactoryCredentialReference(d.Get("credential_name").(string)),
 		}
 	} else if v, ok := d.GetOk("storage_account_key"); ok {
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
@@ -296,8 +304,11 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2Read(d *pluginsdk.Resour
 		d.Set("use_managed_identity", false)
 	}
 
-	if dataLakeStorageGen2.URL != nil {
-		d.Set("url", dataLakeStorageGen2.URL)
+	if props := dataLakeStorageGen2.AzureBlobFSLinkedServiceTypeProperties; props != nil {
+		if props.URL != nil {
+			d.Set("url", props.URL)
+		}
+		d.Set("credential_name", flattenDataFactoryCredentialReference(props.Credential))
 	}
 
 	d.Set("additional_properties", dataLakeStorageGen2.AdditionalProperties)
@@ -68,6 +68,13 @@ func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"service_principal_id", "use_managed_identity"},
 			},
 
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"use_managed_identity"},
+			},
+
 			"service_principal_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -165,8 +172,9 @@ func resourceDataFactoryLinkedServiceKustoCreateUpdate(d *pluginsdk.ResourceData
 
 	if d.Get("use_managed_identity").(bool) {
 		kustoLinkedService.AzureDataExplorerLinkedServiceTypeProperties = &datafactory.AzureDataExplorerLinkedServiceTypeProperties{
-			Endpoint: d.Get("kusto_endpoint").(string),
-			Database: d.Get("kusto_database_name").(string),
+			Endpoint:   d.Get("kusto_endpoint").(string),
+			Database:   d.Get("kusto_database_name").(string),
+			Credential: expandDataFactoryCredentialReference(d.Get("credential_name").(string)),
 		}
 	} else if v, ok := d.GetOk("service_principal_id"); ok {
 		kustoLinkedService.AzureDataExplorerLinkedServiceTypeProperties = &datafactory.AzureDataExplorerLinkedServiceTypeProperties{
@@ -267,6 +275,7 @@ func resourceDataFactoryLinkedServiceKustoRead(d *pluginsdk.ResourceData, meta i
 			useManagedIdentity = false
 		}
 		d.Set("use_managed_identity", useManagedIdentity)
+		d.Set("credential_name", flattenDataFactoryCredentialReference(prop.Credential))
 	}
 
 	return nil
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CredentialId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+func NewCredentialID(subscriptionId, resourceGroup, factoryName, name string) CredentialId {
+	return CredentialId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+func (id CredentialId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Factory Name %q", id.FactoryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Credential", segmentsStr)
+}
+
+func (id CredentialId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/credentials/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// CredentialID parses a Credential ID into an CredentialId struct
+func CredentialID(input string) (*CredentialId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CredentialId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("credentials"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -29,6 +29,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_data_factory":                                       resourceDataFactory(),
+		"azurerm_data_factory_credential_service_principal":          resourceDataFactoryCredentialServicePrincipal(),
+		"azurerm_data_factory_credential_user_managed_identity":      resourceDataFactoryCredentialUserManagedIdentity(),
 		"azurerm_data_factory_data_flow":                             resourceDataFactoryDataFlow(),
 		"azurerm_data_factory_dataset_azure_blob":                    resourceDataFactoryDatasetAzureBlob(),
 		"azurerm_data_factory_dataset_binary":                        resourceDataFactoryDatasetBinary(),
@@ -1,6 +1,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -58,7 +59,6 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 			"sku_name": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringMatch(
 					regexp.MustCompile(`^Dedicated_[1-9][0-9]*$`),
 					"SKU name must match /^Dedicated_[1-9][0-9]*$/.",
@@ -67,6 +67,18 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		// Dedicated clusters can be scaled up in place, but scaling down requires the cluster to be recreated
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldSku := expandEventHubClusterSkuName(old.(string))
+				newSku := expandEventHubClusterSkuName(new.(string))
+				if oldSku == nil || newSku == nil {
+					return false
+				}
+				return *newSku.Capacity < *oldSku.Capacity
+			}),
+		),
 	}
 }
 
@@ -61,6 +61,29 @@ func TestAccEventHubCluster_update(t *testing.T) {
 	})
 }
 
+func TestAccEventHubCluster_scaleUp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_cluster", "test")
+	r := EventHubClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scaledUp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Dedicated_2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (EventHubClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := eventhubsclusters.ParseClusterID(state.ID)
 	if err != nil {
@@ -118,3 +141,23 @@ resource "azurerm_eventhub_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (EventHubClusterResource) scaledUp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_cluster" "test" {
+  name                = "acctesteventhubclusTER-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Dedicated_2"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -48,6 +49,19 @@ func resourceEventHubNamespaceCustomerManagedKey() *pluginsdk.Resource {
 					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 				},
 			},
+
+			"infrastructure_encryption_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: msiValidate.UserAssignedIdentityID,
+			},
 		},
 	}
 }
@@ -83,10 +97,11 @@ func resourceEventHubNamespaceCustomerManagedKeyCreateUpdate(d *pluginsdk.Resour
 
 	keySource := namespaces.KeySourceMicrosoftPointKeyVault
 	namespace.Properties.Encryption = &namespaces.Encryption{
-		KeySource: &keySource,
+		KeySource:                       &keySource,
+		RequireInfrastructureEncryption: utils.Bool(d.Get("infrastructure_encryption_enabled").(bool)),
 	}
 
-	keyVaultProps, err := expandEventHubNamespaceKeyVaultKeyIds(d.Get("key_vault_key_ids").(*pluginsdk.Set).List())
+	keyVaultProps, err := expandEventHubNamespaceKeyVaultKeyIds(d.Get("key_vault_key_ids").(*pluginsdk.Set).List(), d.Get("user_assigned_identity_id").(string))
 	if err != nil {
 		return err
 	}
@@ -122,7 +137,7 @@ func resourceEventHubNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData,
 	if resp.Model == nil {
 		return fmt.Errorf("retrieving %s: `model` was nil", *id)
 	}
-	if resp.Model.Properties == nil && resp.Model.Properties.Encryption == nil {
+	if resp.Model.Properties == nil || resp.Model.Properties.Encryption == nil {
 		d.SetId("")
 		return nil
 	}
@@ -136,6 +151,13 @@ func resourceEventHubNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData,
 		}
 
 		d.Set("key_vault_key_ids", keyVaultKeyIds)
+
+		infrastructureEncryptionEnabled := false
+		if props.Encryption != nil && props.Encryption.RequireInfrastructureEncryption != nil {
+			infrastructureEncryptionEnabled = *props.Encryption.RequireInfrastructureEncryption
+		}
+		d.Set("infrastructure_encryption_enabled", infrastructureEncryptionEnabled)
+		d.Set("user_assigned_identity_id", flattenEventHubNamespaceKeyVaultUserAssignedIdentityId(props.Encryption))
 	}
 
 	return nil
@@ -187,7 +209,7 @@ func resourceEventHubNamespaceCustomerManagedKeyDelete(d *pluginsdk.ResourceData
 	return nil
 }
 
-func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}) (*[]namespaces.KeyVaultProperties, error) {
+func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}, userAssignedIdentityId string) (*[]namespaces.KeyVaultProperties, error) {
 	if len(input) == 0 {
 		return nil, nil
 	}
@@ -200,11 +222,19 @@ func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}) (*[]namespaces.K
 			return nil, err
 		}
 
-		results = append(results, namespaces.KeyVaultProperties{
+		keyVaultProps := namespaces.KeyVaultProperties{
 			KeyName:     utils.String(keyId.Name),
 			KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
 			KeyVersion:  utils.String(keyId.Version),
-		})
+		}
+
+		if userAssignedIdentityId != "" {
+			keyVaultProps.Identity = &namespaces.UserAssignedIdentityProperties{
+				UserAssignedIdentity: utils.String(userAssignedIdentityId),
+			}
+		}
+
+		results = append(results, keyVaultProps)
 	}
 
 	return &results, nil
@@ -242,3 +272,19 @@ func flattenEventHubNamespaceKeyVaultKeyIds(input *namespaces.Encryption) ([]int
 
 	return results, nil
 }
+
+// flattenEventHubNamespaceKeyVaultUserAssignedIdentityId returns the User Assigned Identity used to access the keys,
+// which is the same for every key in the namespace
+func flattenEventHubNamespaceKeyVaultUserAssignedIdentityId(input *namespaces.Encryption) string {
+	if input == nil || input.KeyVaultProperties == nil {
+		return ""
+	}
+
+	for _, item := range *input.KeyVaultProperties {
+		if item.Identity != nil && item.Identity.UserAssignedIdentity != nil {
+			return *item.Identity.UserAssignedIdentity
+		}
+	}
+
+	return ""
+}
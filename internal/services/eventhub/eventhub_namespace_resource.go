@@ -35,7 +35,7 @@ var (
 	eventHubNamespaceResourceName             = "azurerm_eventhub_namespace"
 )
 
-type eventhubNamespaceIdentityType = identity.SystemAssigned
+type eventhubNamespaceIdentityType = identity.SystemAssignedUserAssigned
 
 func resourceEventHubNamespace() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
@@ -242,6 +242,28 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 					}
 				}
 			}
+
+			sku := newSku.(string)
+			if d.Get("auto_inflate_enabled").(bool) && !strings.EqualFold(sku, string(namespaces.SkuNameStandard)) {
+				return fmt.Errorf("`auto_inflate_enabled` can only be set to true when using the Standard SKU")
+			}
+
+			// `capacity` reads as 0 when it's interpolated and not yet known, so it can only be validated once known
+			if !d.NewValueKnown("capacity") {
+				return nil
+			}
+
+			capacity := d.Get("capacity").(int)
+			if err := validateEventHubNamespaceCapacity(sku, capacity); err != nil {
+				return err
+			}
+
+			if d.Get("auto_inflate_enabled").(bool) && d.NewValueKnown("maximum_throughput_units") {
+				if maximumThroughputUnits := d.Get("maximum_throughput_units").(int); maximumThroughputUnits != 0 && maximumThroughputUnits < capacity {
+					return fmt.Errorf("`maximum_throughput_units` (%d) must be greater than or equal to `capacity` (%d) when `auto_inflate_enabled` is true", maximumThroughputUnits, capacity)
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -613,3 +635,22 @@ func flattenEventHubIdentity(input *identity.SystemUserAssignedIdentityMap) []in
 	config := input.ToExpandedConfig()
 	return eventhubNamespaceIdentityType{}.Flatten(&config)
 }
+
+// validateEventHubNamespaceCapacity checks the capacity against the SKU - Basic and Standard namespaces are sized in
+// Throughput Units (up to 40), whilst Premium namespaces are sized in Processing Units which only support fixed values
+func validateEventHubNamespaceCapacity(sku string, capacity int) error {
+	if strings.EqualFold(sku, string(namespaces.SkuNamePremium)) {
+		for _, v := range []int{1, 2, 4, 8, 16} {
+			if capacity == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("`capacity` must be one of 1, 2, 4, 8 or 16 when using the Premium SKU, got %d", capacity)
+	}
+
+	if capacity < 1 || capacity > 40 {
+		return fmt.Errorf("`capacity` must be between 1 and 40 when using the %s SKU, got %d", sku, capacity)
+	}
+
+	return nil
+}
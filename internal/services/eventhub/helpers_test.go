@@ -65,3 +65,28 @@ func TestValidateEventHubName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEventHubNamespaceCapacity(t *testing.T) {
+	tests := []struct {
+		sku      string
+		capacity int
+		valid    bool
+	}{
+		{sku: "Basic", capacity: 1, valid: true},
+		{sku: "Standard", capacity: 40, valid: true},
+		{sku: "Standard", capacity: 0, valid: false},
+		{sku: "Standard", capacity: 41, valid: false},
+		{sku: "Premium", capacity: 1, valid: true},
+		{sku: "Premium", capacity: 16, valid: true},
+		{sku: "premium", capacity: 8, valid: true},
+		{sku: "Premium", capacity: 3, valid: false},
+		{sku: "Premium", capacity: 20, valid: false},
+	}
+	for _, tt := range tests {
+		err := validateEventHubNamespaceCapacity(tt.sku, tt.capacity)
+		valid := err == nil
+		if valid != tt.valid {
+			t.Errorf("Expected valid status %t but got %t for sku %q with capacity %d", tt.valid, valid, tt.sku, tt.capacity)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package iothub
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2021-03-31/devices"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// iotHubEndpointAuthentication contains the fields used by IoT Hub to authenticate against a routing endpoint
+type iotHubEndpointAuthentication struct {
+	AuthenticationType devices.AuthenticationType
+	ConnectionString   *string
+	EndpointUri        *string
+	EntityPath         *string
+	Identity           *devices.ManagedIdentity
+}
+
+// expandIoTHubEndpointAuthentication validates the combination of authentication fields for a routing endpoint.
+// Key based endpoints authenticate using a Connection String, whereas identity based endpoints are addressed using
+// an Endpoint URI (and an Entity Path for Event Hubs and Service Bus) and authenticate using a Managed Identity.
+func expandIoTHubEndpointAuthentication(authenticationType, connectionString, endpointUri, entityPath, identityId string, entityPathSupported bool) (*iotHubEndpointAuthentication, error) {
+	result := iotHubEndpointAuthentication{
+		AuthenticationType: devices.AuthenticationType(authenticationType),
+	}
+
+	if result.AuthenticationType == devices.AuthenticationTypeIdentityBased {
+		if connectionString != "" {
+			return nil, fmt.Errorf("`connection_string` cannot be specified when `authentication_type` is `identityBased`")
+		}
+		if endpointUri == "" {
+			return nil, fmt.Errorf("`endpoint_uri` must be specified when `authentication_type` is `identityBased`")
+		}
+		if entityPathSupported && entityPath == "" {
+			return nil, fmt.Errorf("`entity_path` must be specified when `authentication_type` is `identityBased`")
+		}
+
+		result.EndpointUri = utils.String(endpointUri)
+		if entityPathSupported {
+			result.EntityPath = utils.String(entityPath)
+		}
+		result.Identity = expandIoTHubManagedIdentity(identityId)
+
+		return &result, nil
+	}
+
+	if connectionString == "" {
+		return nil, fmt.Errorf("`connection_string` must be specified when `authentication_type` is `keyBased`")
+	}
+	if endpointUri != "" || entityPath != "" || identityId != "" {
+		return nil, fmt.Errorf("`endpoint_uri`, `entity_path` and `identity_id` can only be specified when `authentication_type` is `identityBased`")
+	}
+	result.ConnectionString = utils.String(connectionString)
+
+	return &result, nil
+}
+
+// expandIoTHubManagedIdentity returns the User Assigned Identity used by an endpoint, when no User Assigned Identity
+// is specified the System Assigned Identity of the IoT Hub is used
+func expandIoTHubManagedIdentity(identityId string) *devices.ManagedIdentity {
+	if identityId == "" {
+		return nil
+	}
+
+	return &devices.ManagedIdentity{
+		UserAssignedIdentity: utils.String(identityId),
+	}
+}
+
+func flattenIoTHubAuthenticationType(input devices.AuthenticationType) string {
+	// endpoints created before identity based authentication was available don't return an authentication type
+	if input == "" {
+		return string(devices.AuthenticationTypeKeyBased)
+	}
+	return string(input)
+}
+
+func flattenIoTHubManagedIdentity(input *devices.ManagedIdentity) string {
+	if input == nil || input.UserAssignedIdentity == nil {
+		return ""
+	}
+	return *input.UserAssignedIdentity
+}
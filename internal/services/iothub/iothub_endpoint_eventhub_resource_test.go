@@ -32,6 +32,22 @@ func TestAccIotHubEndpointEventHub_basic(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointEventHub_identityBased(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_eventhub", "test")
+	r := IotHubEndpointEventHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityBased(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_type").HasValue("identityBased"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccIotHubEndpointEventHub_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_eventhub", "test")
 	r := IotHubEndpointEventHubResource{}
@@ -112,6 +128,75 @@ resource "azurerm_iothub_endpoint_eventhub" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (IotHubEndpointEventHubResource) identityBased(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = "1"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_iothub_endpoint_eventhub" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest"
+
+  authentication_type = "identityBased"
+  identity_id         = azurerm_user_assigned_identity.test.id
+  endpoint_uri        = "sb://${azurerm_eventhub_namespace.test.name}.servicebus.windows.net"
+  entity_path         = azurerm_eventhub.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r IotHubEndpointEventHubResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -13,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -56,7 +58,7 @@ func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
 
 			"connection_string": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
 				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 					sharedAccessKeyRegex := regexp.MustCompile("SharedAccessKey=[^;]+")
 					sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
@@ -67,6 +69,34 @@ func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
 				},
 				Sensitive: true,
 			},
+
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(devices.AuthenticationTypeKeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.AuthenticationTypeKeyBased),
+					string(devices.AuthenticationTypeIdentityBased),
+				}, false),
+			},
+
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: msivalidate.UserAssignedIdentityID,
+			},
+
+			"endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"entity_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 		},
 	}
 }
@@ -92,11 +122,20 @@ func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData
 		return fmt.Errorf("loading IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
 	}
 
+	authentication, err := expandIoTHubEndpointAuthentication(d.Get("authentication_type").(string), d.Get("connection_string").(string), d.Get("endpoint_uri").(string), d.Get("entity_path").(string), d.Get("identity_id").(string), true)
+	if err != nil {
+		return fmt.Errorf("expanding authentication for %s: %+v", id, err)
+	}
+
 	queueEndpoint := devices.RoutingServiceBusQueueEndpointProperties{
-		ConnectionString: utils.String(d.Get("connection_string").(string)),
-		Name:             utils.String(id.EndpointName),
-		SubscriptionID:   utils.String(subscriptionID),
-		ResourceGroup:    utils.String(id.ResourceGroup),
+		ConnectionString:   authentication.ConnectionString,
+		EndpointURI:        authentication.EndpointUri,
+		EntityPath:         authentication.EntityPath,
+		AuthenticationType: authentication.AuthenticationType,
+		Identity:           authentication.Identity,
+		Name:               utils.String(id.EndpointName),
+		SubscriptionID:     utils.String(subscriptionID),
+		ResourceGroup:      utils.String(id.ResourceGroup),
 	}
 
 	routing := iothub.Properties.Routing
@@ -178,6 +217,10 @@ func resourceIotHubEndpointServiceBusQueueRead(d *pluginsdk.ResourceData, meta i
 			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
 				if strings.EqualFold(*existingEndpointName, id.EndpointName) {
 					d.Set("connection_string", endpoint.ConnectionString)
+					d.Set("authentication_type", flattenIoTHubAuthenticationType(endpoint.AuthenticationType))
+					d.Set("identity_id", flattenIoTHubManagedIdentity(endpoint.Identity))
+					d.Set("endpoint_uri", endpoint.EndpointURI)
+					d.Set("entity_path", endpoint.EntityPath)
 				}
 			}
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	iothubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -84,7 +85,7 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 
 			"connection_string": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
 				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 					accountKeyRegex := regexp.MustCompile("AccountKey=[^;]+")
 
@@ -94,6 +95,28 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(devices.AuthenticationTypeKeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.AuthenticationTypeKeyBased),
+					string(devices.AuthenticationTypeIdentityBased),
+				}, false),
+			},
+
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: msivalidate.UserAssignedIdentityID,
+			},
+
+			"endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"encoding": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -128,7 +151,11 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 		return fmt.Errorf("loading IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
 	}
 
-	connectionStr := d.Get("connection_string").(string)
+	authentication, err := expandIoTHubEndpointAuthentication(d.Get("authentication_type").(string), d.Get("connection_string").(string), d.Get("endpoint_uri").(string), "", d.Get("identity_id").(string), false)
+	if err != nil {
+		return fmt.Errorf("expanding authentication for %s: %+v", id, err)
+	}
+
 	containerName := d.Get("container_name").(string)
 	fileNameFormat := d.Get("file_name_format").(string)
 	batchFrequencyInSeconds := int32(d.Get("batch_frequency_in_seconds").(int))
@@ -136,7 +163,10 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 	encoding := d.Get("encoding").(string)
 
 	storageContainerEndpoint := devices.RoutingStorageContainerProperties{
-		ConnectionString:        &connectionStr,
+		ConnectionString:        authentication.ConnectionString,
+		EndpointURI:             authentication.EndpointUri,
+		AuthenticationType:      authentication.AuthenticationType,
+		Identity:                authentication.Identity,
 		Name:                    &id.EndpointName,
 		SubscriptionID:          &subscriptionID,
 		ResourceGroup:           &id.ResourceGroup,
@@ -228,6 +258,9 @@ func resourceIotHubEndpointStorageContainerRead(d *pluginsdk.ResourceData, meta
 			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
 				if strings.EqualFold(*existingEndpointName, id.EndpointName) {
 					d.Set("connection_string", endpoint.ConnectionString)
+					d.Set("authentication_type", flattenIoTHubAuthenticationType(endpoint.AuthenticationType))
+					d.Set("identity_id", flattenIoTHubManagedIdentity(endpoint.Identity))
+					d.Set("endpoint_uri", endpoint.EndpointURI)
 					d.Set("container_name", endpoint.ContainerName)
 					d.Set("file_name_format", endpoint.FileNameFormat)
 					d.Set("batch_frequency_in_seconds", endpoint.BatchFrequencyInSeconds)
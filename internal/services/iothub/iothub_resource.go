@@ -15,9 +15,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	iothubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -30,6 +33,8 @@ import (
 
 var IothubResourceName = "azurerm_iothub"
 
+type iotHubIdentity = identity.SystemAssignedUserAssigned
+
 // nolint unparam
 func suppressIfTypeIsNot(t string) pluginsdk.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *pluginsdk.ResourceData) bool {
@@ -164,6 +169,20 @@ func resourceIotHub() *pluginsdk.Resource {
 							DiffSuppressFunc: fileUploadConnectionStringDiffSuppress,
 							Sensitive:        true,
 						},
+						"authentication_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(devices.AuthenticationTypeKeyBased),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.AuthenticationTypeKeyBased),
+								string(devices.AuthenticationTypeIdentityBased),
+							}, false),
+						},
+						"identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+						},
 						"container_name": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
@@ -221,7 +240,7 @@ func resourceIotHub() *pluginsdk.Resource {
 
 						"connection_string": {
 							Type:     pluginsdk.TypeString,
-							Required: true,
+							Optional: true,
 							DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 								secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
 								sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
@@ -235,6 +254,34 @@ func resourceIotHub() *pluginsdk.Resource {
 							Sensitive: true,
 						},
 
+						"authentication_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(devices.AuthenticationTypeKeyBased),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.AuthenticationTypeKeyBased),
+								string(devices.AuthenticationTypeIdentityBased),
+							}, false),
+						},
+
+						"identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+						},
+
+						"endpoint_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"entity_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
 						"name": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
@@ -455,6 +502,8 @@ func resourceIotHub() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"identity": iotHubIdentity{}.Schema(),
+
 			"type": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -540,18 +589,28 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	if _, ok := d.GetOk("endpoint"); ok {
-		routingProperties.Endpoints = expandIoTHubEndpoints(d, subscriptionId)
+		endpoints, err := expandIoTHubEndpoints(d, subscriptionId)
+		if err != nil {
+			return fmt.Errorf("expanding `endpoint`: %+v", err)
+		}
+		routingProperties.Endpoints = endpoints
 	}
 
-	storageEndpoints, messagingEndpoints, enableFileUploadNotifications := expandIoTHubFileUpload(d)
+	storageEndpoints, messagingEndpoints, enableFileUploadNotifications, err := expandIoTHubFileUpload(d)
 	if err != nil {
 		return fmt.Errorf("expanding `file_upload`: %+v", err)
 	}
 
+	hubIdentity, err := expandIotHubIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
 	props := devices.IotHubDescription{
 		Name:     utils.String(id.Name),
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Sku:      expandIoTHubSku(d),
+		Identity: hubIdentity,
 		Properties: &devices.IotHubProperties{
 			IPFilterRules:                 expandIPFilterRules(d),
 			Routing:                       &routingProperties,
@@ -711,6 +770,15 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `sku`: %+v", err)
 	}
 	d.Set("type", hub.Type)
+
+	hubIdentity, err := flattenIotHubIdentity(hub.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", hubIdentity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, hub.Tags)
 }
 
@@ -854,7 +922,7 @@ func expandIoTHubEnrichments(d *pluginsdk.ResourceData) *[]devices.EnrichmentPro
 	return &enrichmentProperties
 }
 
-func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.StorageEndpointProperties, map[string]*devices.MessagingEndpointProperties, bool) {
+func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.StorageEndpointProperties, map[string]*devices.MessagingEndpointProperties, bool, error) {
 	fileUploadList := d.Get("file_upload").([]interface{})
 
 	storageEndpointProperties := make(map[string]*devices.StorageEndpointProperties)
@@ -871,11 +939,19 @@ func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.Stor
 		sasTTL := fileUploadMap["sas_ttl"].(string)
 		defaultTTL := fileUploadMap["default_ttl"].(string)
 		lockDuration := fileUploadMap["lock_duration"].(string)
+		authenticationType := devices.AuthenticationType(fileUploadMap["authentication_type"].(string))
+		identityId := fileUploadMap["identity_id"].(string)
+
+		if identityId != "" && authenticationType != devices.AuthenticationTypeIdentityBased {
+			return nil, nil, false, fmt.Errorf("`identity_id` can only be specified when `authentication_type` is `identityBased`")
+		}
 
 		storageEndpointProperties["$default"] = &devices.StorageEndpointProperties{
-			SasTTLAsIso8601:  &sasTTL,
-			ConnectionString: &connectionStr,
-			ContainerName:    &containerName,
+			SasTTLAsIso8601:    &sasTTL,
+			ConnectionString:   &connectionStr,
+			ContainerName:      &containerName,
+			AuthenticationType: authenticationType,
+			Identity:           expandIoTHubManagedIdentity(identityId),
 		}
 
 		messagingEndpointProperties["fileNotifications"] = &devices.MessagingEndpointProperties{
@@ -885,10 +961,10 @@ func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.Stor
 		}
 	}
 
-	return storageEndpointProperties, messagingEndpointProperties, notifications
+	return storageEndpointProperties, messagingEndpointProperties, notifications, nil
 }
 
-func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *devices.RoutingEndpoints {
+func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) (*devices.RoutingEndpoints, error) {
 	routeEndpointList := d.Get("endpoint").([]interface{})
 
 	serviceBusQueueEndpointProperties := make([]devices.RoutingServiceBusQueueEndpointProperties, 0)
@@ -900,11 +976,17 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 		endpoint := endpointRaw.(map[string]interface{})
 
 		t := endpoint["type"]
-		connectionStr := endpoint["connection_string"].(string)
 		name := endpoint["name"].(string)
 		resourceGroup := endpoint["resource_group_name"].(string)
 		subscriptionID := subscriptionId
 
+		// storage containers are addressed by `container_name` rather than an entity path
+		entityPathSupported := t != "AzureIotHub.StorageContainer"
+		authentication, err := expandIoTHubEndpointAuthentication(endpoint["authentication_type"].(string), endpoint["connection_string"].(string), endpoint["endpoint_uri"].(string), endpoint["entity_path"].(string), endpoint["identity_id"].(string), entityPathSupported)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %q: %+v", name, err)
+		}
+
 		switch t {
 		case "AzureIotHub.StorageContainer":
 			containerName := endpoint["container_name"].(string)
@@ -914,7 +996,10 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 			encoding := endpoint["encoding"].(string)
 
 			storageContainer := devices.RoutingStorageContainerProperties{
-				ConnectionString:        &connectionStr,
+				ConnectionString:        authentication.ConnectionString,
+				EndpointURI:             authentication.EndpointUri,
+				AuthenticationType:      authentication.AuthenticationType,
+				Identity:                authentication.Identity,
 				Name:                    &name,
 				SubscriptionID:          &subscriptionID,
 				ResourceGroup:           &resourceGroup,
@@ -928,28 +1013,40 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 
 		case "AzureIotHub.ServiceBusQueue":
 			sbQueue := devices.RoutingServiceBusQueueEndpointProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				ConnectionString:   authentication.ConnectionString,
+				EndpointURI:        authentication.EndpointUri,
+				EntityPath:         authentication.EntityPath,
+				AuthenticationType: authentication.AuthenticationType,
+				Identity:           authentication.Identity,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			serviceBusQueueEndpointProperties = append(serviceBusQueueEndpointProperties, sbQueue)
 
 		case "AzureIotHub.ServiceBusTopic":
 			sbTopic := devices.RoutingServiceBusTopicEndpointProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				ConnectionString:   authentication.ConnectionString,
+				EndpointURI:        authentication.EndpointUri,
+				EntityPath:         authentication.EntityPath,
+				AuthenticationType: authentication.AuthenticationType,
+				Identity:           authentication.Identity,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			serviceBusTopicEndpointProperties = append(serviceBusTopicEndpointProperties, sbTopic)
 
 		case "AzureIotHub.EventHub":
 			eventHub := devices.RoutingEventHubProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				ConnectionString:   authentication.ConnectionString,
+				EndpointURI:        authentication.EndpointUri,
+				EntityPath:         authentication.EntityPath,
+				AuthenticationType: authentication.AuthenticationType,
+				Identity:           authentication.Identity,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			eventHubProperties = append(eventHubProperties, eventHub)
 		}
@@ -960,7 +1057,7 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 		ServiceBusTopics:  &serviceBusTopicEndpointProperties,
 		EventHubs:         &eventHubProperties,
 		StorageContainers: &storageContainerProperties,
-	}
+	}, nil
 }
 
 func expandIoTHubFallbackRoute(d *pluginsdk.ResourceData) *devices.FallbackRouteProperties {
@@ -983,6 +1080,59 @@ func expandIoTHubFallbackRoute(d *pluginsdk.ResourceData) *devices.FallbackRoute
 	}
 }
 
+func expandIotHubIdentity(input []interface{}) (*devices.ArmIdentity, error) {
+	config, err := iotHubIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var identityIds map[string]*devices.ArmUserIdentity
+	if len(config.UserAssignedIdentityIds) != 0 {
+		identityIds = map[string]*devices.ArmUserIdentity{}
+		for _, id := range config.UserAssignedIdentityIds {
+			identityIds[id] = &devices.ArmUserIdentity{}
+		}
+	}
+
+	return &devices.ArmIdentity{
+		Type:                   devices.ResourceIdentityType(config.Type),
+		UserAssignedIdentities: identityIds,
+	}, nil
+}
+
+func flattenIotHubIdentity(input *devices.ArmIdentity) ([]interface{}, error) {
+	var config *identity.ExpandedConfig
+
+	if input != nil {
+		var identityIds []string
+		for id := range input.UserAssignedIdentities {
+			parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(id)
+			if err != nil {
+				return nil, err
+			}
+			identityIds = append(identityIds, parsedId.ID())
+		}
+
+		principalId := ""
+		if input.PrincipalID != nil {
+			principalId = *input.PrincipalID
+		}
+
+		tenantId := ""
+		if input.TenantID != nil {
+			tenantId = *input.TenantID
+		}
+
+		config = &identity.ExpandedConfig{
+			Type:                    identity.Type(string(input.Type)),
+			PrincipalId:             principalId,
+			TenantId:                tenantId,
+			UserAssignedIdentityIds: identityIds,
+		}
+	}
+	return iotHubIdentity{}.Flatten(config), nil
+}
+
 func expandIoTHubSku(d *pluginsdk.ResourceData) *devices.IotHubSkuInfo {
 	skuList := d.Get("sku").([]interface{})
 	skuMap := skuList[0].(map[string]interface{})
@@ -1045,6 +1195,8 @@ func flattenIoTHubFileUpload(storageEndpoints map[string]*devices.StorageEndpoin
 		if sasTTLAsIso8601 := storageEndpointProperties.SasTTLAsIso8601; sasTTLAsIso8601 != nil {
 			output["sas_ttl"] = *sasTTLAsIso8601
 		}
+		output["authentication_type"] = flattenIoTHubAuthenticationType(storageEndpointProperties.AuthenticationType)
+		output["identity_id"] = flattenIoTHubManagedIdentity(storageEndpointProperties.Identity)
 
 		if messagingEndpointProperties, ok := messagingEndpoints["fileNotifications"]; ok {
 			if lockDurationAsIso8601 := messagingEndpointProperties.LockDurationAsIso8601; lockDurationAsIso8601 != nil {
@@ -1097,6 +1249,11 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if resourceGroup := container.ResourceGroup; resourceGroup != nil {
 					output["resource_group_name"] = *resourceGroup
 				}
+				if endpointUri := container.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				output["authentication_type"] = flattenIoTHubAuthenticationType(container.AuthenticationType)
+				output["identity_id"] = flattenIoTHubManagedIdentity(container.Identity)
 
 				output["encoding"] = string(container.Encoding)
 				output["type"] = "AzureIotHub.StorageContainer"
@@ -1118,6 +1275,14 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if resourceGroup := queue.ResourceGroup; resourceGroup != nil {
 					output["resource_group_name"] = *resourceGroup
 				}
+				if endpointUri := queue.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := queue.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
+				output["authentication_type"] = flattenIoTHubAuthenticationType(queue.AuthenticationType)
+				output["identity_id"] = flattenIoTHubManagedIdentity(queue.Identity)
 
 				output["type"] = "AzureIotHub.ServiceBusQueue"
 
@@ -1138,6 +1303,14 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if resourceGroup := topic.ResourceGroup; resourceGroup != nil {
 					output["resource_group_name"] = *resourceGroup
 				}
+				if endpointUri := topic.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := topic.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
+				output["authentication_type"] = flattenIoTHubAuthenticationType(topic.AuthenticationType)
+				output["identity_id"] = flattenIoTHubManagedIdentity(topic.Identity)
 
 				output["type"] = "AzureIotHub.ServiceBusTopic"
 
@@ -1158,6 +1331,14 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if resourceGroup := eventHub.ResourceGroup; resourceGroup != nil {
 					output["resource_group_name"] = *resourceGroup
 				}
+				if endpointUri := eventHub.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := eventHub.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
+				output["authentication_type"] = flattenIoTHubAuthenticationType(eventHub.AuthenticationType)
+				output["identity_id"] = flattenIoTHubManagedIdentity(eventHub.Identity)
 
 				output["type"] = "AzureIotHub.EventHub"
 
@@ -163,6 +163,24 @@ func TestAccIotHub_fileUpload(t *testing.T) {
 	})
 }
 
+func TestAccIotHub_identityBasedAuthentication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityBasedAuthentication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("UserAssigned"),
+				check.That(data.ResourceName).Key("file_upload.0.authentication_type").HasValue("identityBased"),
+				check.That(data.ResourceName).Key("endpoint.0.authentication_type").HasValue("identityBased"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccIotHub_withDifferentEndpointResourceGroup(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_iothub", "test")
 	r := IotHubResource{}
@@ -800,6 +818,81 @@ resource "azurerm_iothub" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
 
+func (IotHubResource) identityBasedAuthentication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  file_upload {
+    connection_string   = azurerm_storage_account.test.primary_blob_connection_string
+    container_name      = azurerm_storage_container.test.name
+    authentication_type = "identityBased"
+    identity_id         = azurerm_user_assigned_identity.test.id
+  }
+
+  endpoint {
+    type                = "AzureIotHub.StorageContainer"
+    name                = "export"
+    authentication_type = "identityBased"
+    identity_id         = azurerm_user_assigned_identity.test.id
+    endpoint_uri        = azurerm_storage_account.test.primary_blob_endpoint
+    container_name      = azurerm_storage_container.test.name
+    file_name_format    = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+    encoding            = "Avro"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (IotHubResource) publicAccessEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -108,11 +109,9 @@ func resourceOperationalinsightsDataExportCreateUpdate(d *pluginsdk.ResourceData
 
 	parameters := operationalinsights.DataExport{
 		DataExportProperties: &operationalinsights.DataExportProperties{
-			Destination: &operationalinsights.Destination{
-				ResourceID: utils.String(d.Get("destination_resource_id").(string)),
-			},
-			TableNames: utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
-			Enable:     utils.Bool(d.Get("enabled").(bool)),
+			Destination: expandDataExportDestination(d.Get("destination_resource_id").(string)),
+			TableNames:  utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
+			Enable:      utils.Bool(d.Get("enabled").(bool)),
 		},
 	}
 
@@ -180,6 +179,22 @@ func resourceOperationalinsightsDataExportDelete(d *pluginsdk.ResourceData, meta
 	return nil
 }
 
+// expandDataExportDestination splits an Event Hub ID into the Namespace ID and Event Hub name, which is how the API expects it
+func expandDataExportDestination(input string) *operationalinsights.Destination {
+	if eventHubId, err := eventhubs.ParseEventhubIDInsensitively(input); err == nil {
+		return &operationalinsights.Destination{
+			ResourceID: utils.String(eventhubs.NewNamespaceID(eventHubId.SubscriptionId, eventHubId.ResourceGroupName, eventHubId.NamespaceName).ID()),
+			DestinationMetaData: &operationalinsights.DestinationMetaData{
+				EventHubName: utils.String(eventHubId.EventHubName),
+			},
+		}
+	}
+
+	return &operationalinsights.Destination{
+		ResourceID: utils.String(input),
+	}
+}
+
 func flattenDataExportDestination(input *operationalinsights.Destination) string {
 	if input == nil {
 		return ""
@@ -190,5 +205,11 @@ func flattenDataExportDestination(input *operationalinsights.Destination) string
 		resourceID = *input.ResourceID
 	}
 
+	if input.DestinationMetaData != nil && input.DestinationMetaData.EventHubName != nil && *input.DestinationMetaData.EventHubName != "" {
+		if namespaceId, err := eventhubs.ParseNamespaceIDInsensitively(resourceID); err == nil {
+			resourceID = eventhubs.NewEventhubID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, *input.DestinationMetaData.EventHubName).ID()
+		}
+	}
+
 	return resourceID
 }
@@ -0,0 +1,166 @@
+package loganalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogAnalyticsWorkspaceTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogAnalyticsWorkspaceTableID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(30, 730),
+			},
+		},
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogAnalyticsWorkspaceTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+
+	// tables are managed by the service, so they always exist - we only verify the table is present in the workspace
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+		if err != nil {
+			if utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("%s was not found", id)
+			}
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+
+	parameters := operationalinsights.Table{
+		TableProperties: &operationalinsights.TableProperties{
+			RetentionInDays: utils.Int32(int32(d.Get("retention_in_days").(int))),
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	retentionInDays := 0
+	if props := resp.TableProperties; props != nil && props.RetentionInDays != nil {
+		retentionInDays = int(*props.RetentionInDays)
+	}
+	d.Set("retention_in_days", retentionInDays)
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	workspacesClient := meta.(*clients.Client).LogAnalytics.WorkspacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// tables can't be deleted, so instead the retention is reset to match the workspace
+	workspace, err := workspacesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(workspace.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Log Analytics Workspace for %s: %+v", *id, err)
+	}
+
+	if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.RetentionInDays == nil {
+		return fmt.Errorf("retrieving Log Analytics Workspace for %s: `retentionInDays` was nil", *id)
+	}
+
+	parameters := operationalinsights.Table{
+		TableProperties: &operationalinsights.TableProperties{
+			RetentionInDays: workspace.WorkspaceProperties.RetentionInDays,
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		return fmt.Errorf("resetting retention for %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -266,7 +266,7 @@ func resourceLogicAppStandardCreate(d *pluginsdk.ResourceData, meta interface{})
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, strings.ToLower(id.SiteName)+"-content")
 	if err != nil {
 		return err
 	}
@@ -345,7 +345,7 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 	httpsOnly := d.Get("https_only").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
-	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, strings.ToLower(id.SiteName)+"-content")
 	if err != nil {
 		return err
 	}
@@ -363,7 +363,7 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 	siteConfig.AppSettings = &basicAppSettings
 
 	// WEBSITE_VNET_ROUTE_ALL is superseded by a setting in site_config that defaults to false from 2021-02-01
-	appSettings, err := expandLogicAppStandardSettings(d, endpointSuffix)
+	appSettings, err := expandLogicAppStandardSettings(d, endpointSuffix, strings.ToLower(id.SiteName)+"-content")
 	if err != nil {
 		return fmt.Errorf("expanding `app_settings`: %+v", err)
 	}
@@ -606,7 +606,9 @@ func resourceLogicAppStandardDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
-func getBasicLogicAppSettings(d *pluginsdk.ResourceData, endpointSuffix string) ([]web.NameValuePair, error) {
+// getBasicLogicAppSettings returns the app settings required by the Logic App runtime, using defaultContentShare
+// as the content share name when `storage_account_share_name` isn't set.
+func getBasicLogicAppSettings(d *pluginsdk.ResourceData, endpointSuffix string, defaultContentShare string) ([]web.NameValuePair, error) {
 	storagePropName := "AzureWebJobsStorage"
 	functionVersionPropName := "FUNCTIONS_EXTENSION_VERSION"
 	contentSharePropName := "WEBSITE_CONTENTSHARE"
@@ -619,7 +621,7 @@ func getBasicLogicAppSettings(d *pluginsdk.ResourceData, endpointSuffix string)
 	storageConnection := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", storageAccount, accountKey, endpointSuffix)
 	functionVersion := d.Get("version").(string)
 
-	contentShare := strings.ToLower(d.Get("name").(string)) + "-content"
+	contentShare := defaultContentShare
 	if _, ok := d.GetOk("storage_account_share_name"); ok {
 		contentShare = d.Get("storage_account_share_name").(string)
 	}
@@ -1276,10 +1278,10 @@ func expandLogicAppStandardIdentity(input []interface{}) *web.ManagedServiceIden
 	return &managedServiceIdentity
 }
 
-func expandLogicAppStandardSettings(d *pluginsdk.ResourceData, endpointSuffix string) (map[string]*string, error) {
+func expandLogicAppStandardSettings(d *pluginsdk.ResourceData, endpointSuffix string, defaultContentShare string) (map[string]*string, error) {
 	output := make(map[string]*string)
 	appSettings := expandAppSettings(d)
-	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, defaultContentShare)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,580 @@
+package logic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogicAppStandardSlot() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardSlotCreate,
+		Read:   resourceLogicAppStandardSlotRead,
+		Update: resourceLogicAppStandardSlotUpdate,
+		Delete: resourceLogicAppStandardSlotDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardSlotID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardName,
+			},
+
+			"logic_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"app_service_plan_id": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"use_extension_bundle": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"bundle_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "[1.*, 2.0.0)",
+			},
+
+			"client_affinity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"client_certificate_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Required",
+					"Optional",
+				}, false),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": schemaLogicAppStandardIdentity(),
+
+			"site_config": schemaLogicAppStandardSiteConfig(),
+
+			"connection_string": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.ConnectionStringTypeAPIHub),
+								string(web.ConnectionStringTypeCustom),
+								string(web.ConnectionStringTypeDocDb),
+								string(web.ConnectionStringTypeEventHub),
+								string(web.ConnectionStringTypeMySQL),
+								string(web.ConnectionStringTypeNotificationHub),
+								string(web.ConnectionStringTypePostgreSQL),
+								string(web.ConnectionStringTypeRedisCache),
+								string(web.ConnectionStringTypeServiceBus),
+								string(web.ConnectionStringTypeSQLAzure),
+								string(web.ConnectionStringTypeSQLServer),
+							}, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"value": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountName,
+			},
+
+			"storage_account_access_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_share_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "~3",
+			},
+
+			"tags": tags.Schema(),
+
+			// Computed Only
+			"custom_domain_verification_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"default_hostname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"possible_outbound_ip_addresses": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"site_credential": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"username": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"password": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLogicAppStandardSlotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	logicAppId, err := parse.LogicAppStandardID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardSlotID(logicAppId.SubscriptionId, logicAppId.ResourceGroup, logicAppId.SiteName, d.Get("name").(string))
+	existing, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_logic_app_standard_slot", id.ID())
+	}
+
+	clientCertMode := d.Get("client_certificate_mode").(string)
+
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, logicAppStandardSlotContentShareName(id))
+	if err != nil {
+		return err
+	}
+
+	siteConfig, err := expandLogicAppStandardSiteConfig(d)
+	if err != nil {
+		return fmt.Errorf("expanding `site_config`: %+v", err)
+	}
+
+	kind := "functionapp,workflowapp"
+	if siteConfig.LinuxFxVersion != nil && len(*siteConfig.LinuxFxVersion) > 0 {
+		kind = "functionapp,linux,container,workflowapp"
+	}
+
+	// Some appSettings declared by user are required at creation time so we will combine both settings
+	appSettings := expandAppSettings(d)
+	appSettings = append(appSettings, basicAppSettings...)
+
+	siteConfig.AppSettings = &appSettings
+
+	siteEnvelope := web.Site{
+		Kind:     &kind,
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(d.Get("app_service_plan_id").(string)),
+			Enabled:               utils.Bool(d.Get("enabled").(bool)),
+			ClientAffinityEnabled: utils.Bool(d.Get("client_affinity_enabled").(bool)),
+			ClientCertEnabled:     utils.Bool(clientCertMode != ""),
+			HTTPSOnly:             utils.Bool(d.Get("https_only").(bool)),
+			SiteConfig:            &siteConfig,
+		},
+	}
+
+	if clientCertMode != "" {
+		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandLogicAppStandardIdentity(d.Get("identity").([]interface{}))
+	}
+
+	future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, siteEnvelope, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceLogicAppStandardSlotUpdate(d, meta)
+}
+
+func resourceLogicAppStandardSlotUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	clientCertMode := d.Get("client_certificate_mode").(string)
+
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, logicAppStandardSlotContentShareName(*id))
+	if err != nil {
+		return err
+	}
+
+	siteConfig, err := expandLogicAppStandardSiteConfig(d)
+	if err != nil {
+		return fmt.Errorf("expanding `site_config`: %+v", err)
+	}
+
+	kind := "functionapp,workflowapp"
+	if siteConfig.LinuxFxVersion != nil && len(*siteConfig.LinuxFxVersion) > 0 {
+		kind = "functionapp,linux,container,workflowapp"
+	}
+
+	siteConfig.AppSettings = &basicAppSettings
+
+	appSettings, err := expandLogicAppStandardSettings(d, endpointSuffix, logicAppStandardSlotContentShareName(*id))
+	if err != nil {
+		return fmt.Errorf("expanding `app_settings`: %+v", err)
+	}
+	if vnetRouteAll, ok := appSettings["WEBSITE_VNET_ROUTE_ALL"]; ok {
+		if !d.HasChange("site_config.0.vnet_route_all_enabled") {
+			vnetRouteAllEnabled, _ := strconv.ParseBool(*vnetRouteAll)
+			siteConfig.VnetRouteAllEnabled = &vnetRouteAllEnabled
+		}
+	}
+
+	siteEnvelope := web.Site{
+		Kind:     &kind,
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(d.Get("app_service_plan_id").(string)),
+			Enabled:               utils.Bool(d.Get("enabled").(bool)),
+			ClientAffinityEnabled: utils.Bool(d.Get("client_affinity_enabled").(bool)),
+			ClientCertEnabled:     utils.Bool(clientCertMode != ""),
+			HTTPSOnly:             utils.Bool(d.Get("https_only").(bool)),
+			SiteConfig:            &siteConfig,
+		},
+	}
+
+	if clientCertMode != "" {
+		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandLogicAppStandardIdentity(d.Get("identity").([]interface{}))
+	}
+
+	future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, siteEnvelope, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	settings := web.StringDictionary{
+		Properties: appSettings,
+	}
+
+	if _, err = client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, settings, id.SlotName); err != nil {
+		return fmt.Errorf("updating Application Settings for %s: %+v", *id, err)
+	}
+
+	if d.HasChange("site_config") {
+		siteConfig, err := expandLogicAppStandardSiteConfig(d)
+		if err != nil {
+			return fmt.Errorf("expanding `site_config`: %+v", err)
+		}
+		siteConfigResource := web.SiteConfigResource{
+			SiteConfig: &siteConfig,
+		}
+
+		if _, err := client.CreateOrUpdateConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, siteConfigResource, id.SlotName); err != nil {
+			return fmt.Errorf("updating Configuration for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		properties := web.ConnectionStringDictionary{
+			Properties: expandLogicAppStandardConnectionStrings(d),
+		}
+
+		if _, err := client.UpdateConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, properties, id.SlotName); err != nil {
+			return fmt.Errorf("updating Connection Strings for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceLogicAppStandardSlotRead(d, meta)
+}
+
+func resourceLogicAppStandardSlotRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing application settings for %s: %+v", *id, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing connection strings for %s: %+v", *id, err)
+	}
+
+	siteCredFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing publishing credentials for %s: %+v", *id, err)
+	}
+	if err = siteCredFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to list the publishing credentials for %s: %+v", *id, err)
+	}
+	siteCredResp, err := siteCredFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the publishing credentials for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SlotName)
+	d.Set("logic_app_id", parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+	d.Set("kind", resp.Kind)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("enabled", props.Enabled)
+		d.Set("default_hostname", props.DefaultHostName)
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("custom_domain_verification_id", props.CustomDomainVerificationID)
+
+		clientCertMode := ""
+		if props.ClientCertEnabled != nil && *props.ClientCertEnabled {
+			clientCertMode = string(props.ClientCertMode)
+		}
+		d.Set("client_certificate_mode", clientCertMode)
+	}
+
+	appSettings := flattenLogicAppStandardAppSettings(appSettingsResp.Properties)
+
+	if err = d.Set("connection_string", flattenLogicAppStandardConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	// This teases out the necessary attributes from the storage connection string
+	for _, part := range strings.Split(appSettings["AzureWebJobsStorage"], ";") {
+		if strings.HasPrefix(part, "AccountName") {
+			accountNameParts := strings.Split(part, "AccountName=")
+			if len(accountNameParts) > 1 {
+				d.Set("storage_account_name", accountNameParts[1])
+			}
+		}
+		if strings.HasPrefix(part, "AccountKey") {
+			accountKeyParts := strings.Split(part, "AccountKey=")
+			if len(accountKeyParts) > 1 {
+				d.Set("storage_account_access_key", accountKeyParts[1])
+			}
+		}
+	}
+
+	d.Set("version", appSettings["FUNCTIONS_EXTENSION_VERSION"])
+
+	if _, ok := appSettings["AzureFunctionsJobHost__extensionBundle__id"]; ok {
+		d.Set("use_extension_bundle", true)
+		if val, ok := appSettings["AzureFunctionsJobHost__extensionBundle__version"]; ok {
+			d.Set("bundle_version", val)
+		}
+	} else {
+		d.Set("use_extension_bundle", false)
+		d.Set("bundle_version", "[1.*, 2.0.0)")
+	}
+
+	d.Set("storage_account_share_name", appSettings["WEBSITE_CONTENTSHARE"])
+
+	// Remove all the settings that are created by this resource so we don't to have to specify in app_settings
+	// block whenever we use azurerm_logic_app_standard_slot.
+	delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
+	delete(appSettings, "APP_KIND")
+	delete(appSettings, "AzureFunctionsJobHost__extensionBundle__id")
+	delete(appSettings, "AzureFunctionsJobHost__extensionBundle__version")
+	delete(appSettings, "AzureWebJobsDashboard")
+	delete(appSettings, "AzureWebJobsStorage")
+	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
+	delete(appSettings, "WEBSITE_CONTENTSHARE")
+
+	if err = d.Set("app_settings", appSettings); err != nil {
+		return err
+	}
+
+	if err := d.Set("identity", flattenLogicAppStandardIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %s", err)
+	}
+
+	configResp, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("retrieving the configuration for %s: %+v", *id, err)
+	}
+
+	if err = d.Set("site_config", flattenLogicAppStandardSiteConfig(configResp.SiteConfig)); err != nil {
+		return err
+	}
+
+	if err = d.Set("site_credential", flattenLogicAppStandardSiteCredential(siteCredResp.UserProperties)); err != nil {
+		return err
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceLogicAppStandardSlotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	if _, err := client.DeleteSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// logicAppStandardSlotContentShareName returns the default content share for a slot, which must not collide
+// with the share used by the production slot of the same Logic App.
+func logicAppStandardSlotContentShareName(id parse.LogicAppStandardSlotId) string {
+	return strings.ToLower(fmt.Sprintf("%s-%s-content", id.SiteName, id.SlotName))
+}
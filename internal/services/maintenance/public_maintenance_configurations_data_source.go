@@ -0,0 +1,184 @@
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/maintenance/mgmt/2021-05-01/maintenance"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourcePublicMaintenanceConfigurations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePublicMaintenanceConfigurationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				StateFunc:        azure.NormalizeLocation,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					string(maintenance.ScopeExtension),
+					string(maintenance.ScopeHost),
+					string(maintenance.ScopeInGuestPatch),
+					string(maintenance.ScopeOSImage),
+					string(maintenance.ScopeSQLDB),
+					string(maintenance.ScopeSQLManagedInstance),
+				}, false),
+			},
+
+			"recur_every": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"configs": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"maintenance_scope": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"time_zone": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"recur_every": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePublicMaintenanceConfigurationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.PublicConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resp, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving Public Maintenance Configurations: %+v", err)
+	}
+
+	locationFilter := azure.NormalizeLocation(d.Get("location").(string))
+	scopeFilter := d.Get("scope").(string)
+	recurEveryFilter := d.Get("recur_every").(string)
+
+	configs := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, config := range *resp.Value {
+			configLocation := location.NormalizeNilable(config.Location)
+			if locationFilter != "" && locationFilter != configLocation {
+				continue
+			}
+
+			var description, duration, scope, timeZone, recurEvery string
+			if props := config.ConfigurationProperties; props != nil {
+				scope = string(props.MaintenanceScope)
+				if v, ok := props.ExtensionProperties["description"]; ok && v != nil {
+					description = *v
+				}
+
+				if window := props.Window; window != nil {
+					if window.Duration != nil {
+						duration = *window.Duration
+					}
+					if window.TimeZone != nil {
+						timeZone = *window.TimeZone
+					}
+					if window.RecurEvery != nil {
+						recurEvery = *window.RecurEvery
+					}
+				}
+			}
+
+			if scopeFilter != "" && scopeFilter != "All" && !strings.EqualFold(scopeFilter, scope) {
+				continue
+			}
+
+			if recurEveryFilter != "" && !strings.EqualFold(recurEveryFilter, recurEvery) {
+				continue
+			}
+
+			id := ""
+			if config.ID != nil {
+				id = *config.ID
+			}
+
+			name := ""
+			if config.Name != nil {
+				name = *config.Name
+			}
+
+			configs = append(configs, map[string]interface{}{
+				"id":                id,
+				"name":              name,
+				"location":          configLocation,
+				"description":       description,
+				"duration":          duration,
+				"maintenance_scope": scope,
+				"time_zone":         timeZone,
+				"recur_every":       recurEvery,
+			})
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("configs", configs); err != nil {
+		return fmt.Errorf("setting `configs`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package maintenance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PublicMaintenanceConfigurationsDataSource struct{}
+
+func TestAccPublicMaintenanceConfigurationsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_public_maintenance_configurations", "test")
+	r := PublicMaintenanceConfigurationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("configs.0.name").Exists(),
+				check.That(data.ResourceName).Key("configs.0.maintenance_scope").HasValue("SQLDB"),
+			),
+		},
+	})
+}
+
+func (PublicMaintenanceConfigurationsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_public_maintenance_configurations" "test" {
+  location = "%s"
+  scope    = "SQLDB"
+}
+`, data.Locations.Primary)
+}
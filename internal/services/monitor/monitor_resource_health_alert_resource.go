@@ -0,0 +1,309 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2020-10-01/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	resourceHealthFieldCurrentHealthStatus  = "properties.currentHealthStatus"
+	resourceHealthFieldPreviousHealthStatus = "properties.previousHealthStatus"
+	resourceHealthFieldCause                = "properties.cause"
+)
+
+func resourceMonitorResourceHealthAlert() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorResourceHealthAlertCreateUpdate,
+		Read:   resourceMonitorResourceHealthAlertRead,
+		Update: resourceMonitorResourceHealthAlertCreateUpdate,
+		Delete: resourceMonitorResourceHealthAlertDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ActivityLogAlertID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"current_health_status": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(resourceHealthStatuses(), false),
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"previous_health_status": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(resourceHealthStatuses(), false),
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"reason_type": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"PlatformInitiated",
+						"UserInitiated",
+						"Unknown",
+					}, false),
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"webhook_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+				Set: resourceMonitorActivityLogAlertActionHash,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorResourceHealthAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewActivityLogAlertID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Resource Health Alert %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_monitor_resource_health_alert", *existing.ID)
+		}
+	}
+
+	conditions := []insights.AlertRuleAnyOfOrLeafCondition{
+		{
+			Field:  utils.String("category"),
+			Equals: utils.String("ResourceHealth"),
+		},
+	}
+	conditions = appendResourceHealthAnyOfCondition(conditions, resourceHealthFieldCurrentHealthStatus, d.Get("current_health_status").(*pluginsdk.Set).List())
+	conditions = appendResourceHealthAnyOfCondition(conditions, resourceHealthFieldPreviousHealthStatus, d.Get("previous_health_status").(*pluginsdk.Set).List())
+	conditions = appendResourceHealthAnyOfCondition(conditions, resourceHealthFieldCause, d.Get("reason_type").(*pluginsdk.Set).List())
+
+	parameters := insights.ActivityLogAlertResource{
+		Location: utils.String(azure.NormalizeLocation("Global")),
+		AlertRuleProperties: &insights.AlertRuleProperties{
+			Enabled:     utils.Bool(d.Get("enabled").(bool)),
+			Description: utils.String(d.Get("description").(string)),
+			Scopes:      utils.ExpandStringSlice(d.Get("scopes").(*pluginsdk.Set).List()),
+			Condition: &insights.AlertRuleAllOfCondition{
+				AllOf: &conditions,
+			},
+			Actions: expandMonitorActivityLogAlertAction(d.Get("action").(*pluginsdk.Set).List()),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating or updating Resource Health Alert %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorResourceHealthAlertRead(d, meta)
+}
+
+func resourceMonitorResourceHealthAlertRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActivityLogAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Resource Health Alert %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if alert := resp.AlertRuleProperties; alert != nil {
+		d.Set("enabled", alert.Enabled)
+		d.Set("description", alert.Description)
+		if err := d.Set("scopes", utils.FlattenStringSlice(alert.Scopes)); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		conditions := flattenResourceHealthAnyOfConditions(alert.Condition)
+		if err := d.Set("current_health_status", conditions[strings.ToLower(resourceHealthFieldCurrentHealthStatus)]); err != nil {
+			return fmt.Errorf("setting `current_health_status`: %+v", err)
+		}
+		if err := d.Set("previous_health_status", conditions[strings.ToLower(resourceHealthFieldPreviousHealthStatus)]); err != nil {
+			return fmt.Errorf("setting `previous_health_status`: %+v", err)
+		}
+		if err := d.Set("reason_type", conditions[strings.ToLower(resourceHealthFieldCause)]); err != nil {
+			return fmt.Errorf("setting `reason_type`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorActivityLogAlertAction(alert.Actions)); err != nil {
+			return fmt.Errorf("setting `action`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMonitorResourceHealthAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActivityLogAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Resource Health Alert %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceHealthStatuses() []string {
+	return []string{
+		"Available",
+		"Degraded",
+		"Unavailable",
+		"Unknown",
+	}
+}
+
+func appendResourceHealthAnyOfCondition(conditions []insights.AlertRuleAnyOfOrLeafCondition, field string, values []interface{}) []insights.AlertRuleAnyOfOrLeafCondition {
+	if len(values) == 0 {
+		return conditions
+	}
+
+	anyOf := make([]insights.AlertRuleLeafCondition, 0)
+	for _, v := range values {
+		anyOf = append(anyOf, insights.AlertRuleLeafCondition{
+			Field:  utils.String(field),
+			Equals: utils.String(v.(string)),
+		})
+	}
+
+	return append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+		AnyOf: &anyOf,
+	})
+}
+
+// flattenResourceHealthAnyOfConditions returns the values of each `anyOf` condition, keyed by the lower-cased field name
+func flattenResourceHealthAnyOfConditions(input *insights.AlertRuleAllOfCondition) map[string][]string {
+	result := make(map[string][]string)
+	if input == nil || input.AllOf == nil {
+		return result
+	}
+
+	for _, condition := range *input.AllOf {
+		if condition.AnyOf == nil {
+			continue
+		}
+		for _, leaf := range *condition.AnyOf {
+			if leaf.Field == nil || leaf.Equals == nil {
+				continue
+			}
+			field := strings.ToLower(*leaf.Field)
+			result[field] = append(result[field], *leaf.Equals)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,167 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorResourceHealthAlertResource struct{}
+
+func TestAccMonitorResourceHealthAlert_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_resource_health_alert", "test")
+	r := MonitorResourceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("current_health_status.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorResourceHealthAlert_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_resource_health_alert", "test")
+	r := MonitorResourceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_monitor_resource_health_alert"),
+		},
+	})
+}
+
+func TestAccMonitorResourceHealthAlert_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_resource_health_alert", "test")
+	r := MonitorResourceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_health_status.#").HasValue("2"),
+				check.That(data.ResourceName).Key("previous_health_status.#").HasValue("1"),
+				check.That(data.ResourceName).Key("reason_type.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MonitorResourceHealthAlertResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ActivityLogAlertID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActivityLogAlertsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MonitorResourceHealthAlertResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_resource_health_alert" "test" {
+  name                = "acctestResourceHealthAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorResourceHealthAlertResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_resource_health_alert" "import" {
+  name                = azurerm_monitor_resource_health_alert.test.name
+  resource_group_name = azurerm_monitor_resource_health_alert.test.resource_group_name
+  scopes              = [azurerm_resource_group.test.id]
+}
+`, r.basic(data))
+}
+
+func (MonitorResourceHealthAlertResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_resource_health_alert" "test" {
+  name                = "acctestResourceHealthAlert-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  description         = "Resources in this group have become unhealthy"
+
+  current_health_status  = ["Degraded", "Unavailable"]
+  previous_health_status = ["Available"]
+  reason_type            = ["PlatformInitiated"]
+
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -75,11 +76,21 @@ func dataSourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"zone_redundant": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
 			},
 
+			"maintenance_configuration_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -126,6 +137,17 @@ func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("sku_name", props.CurrentServiceObjectiveName)
 		d.Set("storage_account_type", flattenMsSqlBackupStorageRedundancy(props.CurrentBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
+		d.Set("ledger_enabled", props.IsLedgerOn)
+
+		maintenanceConfigurationName := ""
+		if props.MaintenanceConfigurationID != nil {
+			maintenanceConfigId, err := maintenanceParse.PublicMaintenanceConfigurationID(*props.MaintenanceConfigurationID)
+			if err != nil {
+				return err
+			}
+			maintenanceConfigurationName = maintenanceConfigId.Name
+		}
+		d.Set("maintenance_configuration_name", maintenanceConfigurationName)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -0,0 +1,145 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlDatabaseLedgerDigestUpload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlDatabaseLedgerDigestUploadCreateUpdate,
+		Read:   resourceMsSqlDatabaseLedgerDigestUploadRead,
+		Update: resourceMsSqlDatabaseLedgerDigestUploadCreateUpdate,
+		Delete: resourceMsSqlDatabaseLedgerDigestUploadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DatabaseLedgerDigestUploadID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseID,
+			},
+
+			// either the primary blob endpoint of a Storage Account or the ledger endpoint of an Azure Confidential Ledger
+			"digest_storage_endpoint": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+		},
+	}
+}
+
+func resourceMsSqlDatabaseLedgerDigestUploadCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the API only supports a single digest upload configuration per database, which is always named `current`
+	id := parse.NewDatabaseLedgerDigestUploadID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, "current")
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		// digest uploads are always present on the database, so only treat them as existing when enabled
+		if existing.LedgerDigestUploadsProperties != nil && existing.LedgerDigestUploadsProperties.State == sql.LedgerDigestUploadsStateEnabled {
+			return tf.ImportAsExistsError("azurerm_mssql_database_ledger_digest_upload", id.ID())
+		}
+	}
+
+	params := sql.LedgerDigestUploads{
+		LedgerDigestUploadsProperties: &sql.LedgerDigestUploadsProperties{
+			DigestStorageEndpoint: utils.String(d.Get("digest_storage_endpoint").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, params); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlDatabaseLedgerDigestUploadRead(d, meta)
+}
+
+func resourceMsSqlDatabaseLedgerDigestUploadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseLedgerDigestUploadID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.LedgerDigestUploadsProperties; props == nil || props.State == sql.LedgerDigestUploadsStateDisabled {
+		log.Printf("[DEBUG] %s is disabled - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("database_id", parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName).ID())
+	d.Set("digest_storage_endpoint", resp.LedgerDigestUploadsProperties.DigestStorageEndpoint)
+
+	return nil
+}
+
+func resourceMsSqlDatabaseLedgerDigestUploadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseLedgerDigestUploadID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Disable(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName); err != nil {
+		return fmt.Errorf("disabling %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlDatabaseLedgerDigestUploadResource struct{}
+
+func TestAccMsSqlDatabaseLedgerDigestUpload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_ledger_digest_upload", "test")
+	r := MsSqlDatabaseLedgerDigestUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseLedgerDigestUpload_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_ledger_digest_upload", "test")
+	r := MsSqlDatabaseLedgerDigestUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (MsSqlDatabaseLedgerDigestUploadResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseLedgerDigestUploadID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.LedgerDigestUploadsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.LedgerDigestUploadsProperties != nil && resp.LedgerDigestUploadsProperties.State == sql.LedgerDigestUploadsStateEnabled), nil
+}
+
+func (MsSqlDatabaseLedgerDigestUploadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "AdminPassword123!"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_mssql_database" "test" {
+  name           = "acctest-db-%[1]d"
+  server_id      = azurerm_mssql_server.test.id
+  ledger_enabled = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_mssql_server.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MsSqlDatabaseLedgerDigestUploadResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_ledger_digest_upload" "test" {
+  database_id             = azurerm_mssql_database.test.id
+  digest_storage_endpoint = azurerm_storage_account.test.primary_blob_endpoint
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabaseLedgerDigestUploadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_ledger_digest_upload" "import" {
+  database_id             = azurerm_mssql_database_ledger_digest_upload.test.database_id
+  digest_storage_endpoint = azurerm_mssql_database_ledger_digest_upload.test.digest_storage_endpoint
+}
+`, r.basic(data))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,10 +17,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -28,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var msSqlDatabaseStandardSkuRegex = regexp.MustCompile(`^S\d+$`)
+
 func resourceMsSqlDatabase() *pluginsdk.Resource {
 	resourceData := &pluginsdk.Resource{
 		Create: resourceMsSqlDatabaseCreateUpdate,
@@ -93,6 +98,69 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"import": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"creation_source_database_id", "recover_database_id", "restore_dropped_database_id"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_uri": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"storage_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"storage_key_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.StorageKeyTypeSharedAccessKey),
+								string(sql.StorageKeyTypeStorageAccessKey),
+							}, false),
+						},
+
+						"administrator_login": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"administrator_login_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"authentication_type": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ADPassword",
+								"SQL",
+							}, true),
+						},
+
+						// when set a private endpoint connection is created to both the storage account and the server
+						"storage_account_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: storageValidate.StorageAccountID,
+						},
+					},
+				},
+			},
+
 			"collation": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -109,6 +177,13 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 
 			"extended_auditing_policy": helper.ExtendedAuditingSchema(),
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
 			"license_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -170,6 +245,17 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"secondary_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.SecondaryTypeGeo),
+					string(sql.SecondaryTypeNamed),
+				}, false),
+			},
+
 			"sample_name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -213,6 +299,13 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"maintenance_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.DatabaseMaintenanceConfigurationName,
+			},
+
 			"threat_detection_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -314,6 +407,23 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				// "hyperscale can not change to other sku
 				return strings.HasPrefix(old.(string), "HS") && !strings.HasPrefix(new.(string), "HS")
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// zone redundancy isn't available for the Basic and Standard DTU tiers
+				sku := d.Get("sku_name").(string)
+				if d.Get("zone_redundant").(bool) && (strings.EqualFold(sku, "Basic") || msSqlDatabaseStandardSkuRegex.MatchString(sku)) {
+					return fmt.Errorf("`zone_redundant` cannot be enabled for the Basic and Standard service tiers, got `sku_name` %q", sku)
+				}
+
+				// named replicas are only supported for Hyperscale databases
+				if secondaryType := d.Get("secondary_type").(string); secondaryType == string(sql.SecondaryTypeNamed) && sku != "" && !strings.HasPrefix(sku, "HS") {
+					return fmt.Errorf("a `secondary_type` of %q can only be used with Hyperscale databases, got `sku_name` %q", secondaryType, sku)
+				}
+
+				if _, ok := d.GetOk("import"); ok && d.Get("create_mode").(string) != string(sql.CreateModeDefault) {
+					return fmt.Errorf("`import` can only be used when `create_mode` is `%s`", string(sql.CreateModeDefault))
+				}
+				return nil
+			},
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				if !features.ThreePointOh() {
 					return nil
@@ -508,6 +618,14 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("maintenance_configuration_name"); ok {
+		params.DatabaseProperties.MaintenanceConfigurationID = utils.String(maintenanceParse.NewPublicMaintenanceConfigurationID(serverId.SubscriptionId, v.(string)).ID())
+	}
+
+	if d.IsNewResource() && d.Get("ledger_enabled").(bool) {
+		params.DatabaseProperties.IsLedgerOn = utils.Bool(true)
+	}
+
 	createMode, ok := d.GetOk("create_mode")
 	if _, dbok := d.GetOk("creation_source_database_id"); ok && (createMode.(string) == string(sql.CreateModeCopy) || createMode.(string) == string(sql.CreateModePointInTimeRestore) || createMode.(string) == string(sql.CreateModeSecondary)) && !dbok {
 		return fmt.Errorf("'creation_source_database_id' is required for create_mode %s", createMode.(string))
@@ -521,6 +639,13 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 
 	params.DatabaseProperties.CreateMode = sql.CreateMode(createMode.(string))
 
+	if v, ok := d.GetOk("secondary_type"); ok && d.IsNewResource() {
+		if createMode.(string) != string(sql.CreateModeSecondary) && createMode.(string) != string(sql.CreateModeOnlineSecondary) {
+			return fmt.Errorf("`secondary_type` can only be set when `create_mode` is `%s` or `%s`", string(sql.CreateModeSecondary), string(sql.CreateModeOnlineSecondary))
+		}
+		params.DatabaseProperties.SecondaryType = sql.SecondaryType(v.(string))
+	}
+
 	auditingPolicies := d.Get("extended_auditing_policy").([]interface{})
 	if (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary)) && len(auditingPolicies) > 0 {
 		return fmt.Errorf("cannot configure `extended_auditing_policy` in secondary create mode for %s", id)
@@ -582,6 +707,20 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
 	}
 
+	d.SetId(id.ID())
+
+	// the ID is set before the import, so that a failed import taints the database rather than leaving it untracked
+	if v, ok := d.GetOk("import"); ok && d.IsNewResource() {
+		importFuture, err := client.Import(ctx, id.ResourceGroup, id.ServerName, id.Name, expandMsSqlDatabaseImport(v.([]interface{}), id))
+		if err != nil {
+			return fmt.Errorf("importing into %s: %+v", id, err)
+		}
+
+		if err = importFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for import into %s: %+v", id, err)
+		}
+	}
+
 	if features.ThreePointOh() {
 		statusProperty := sql.TransparentDataEncryptionStatusDisabled
 		encryptionStatus := d.Get("transparent_data_encryption_enabled").(bool)
@@ -613,8 +752,6 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 
 	}
 
-	d.SetId(id.ID())
-
 	// For datawarehouse SKUs only
 	if strings.HasPrefix(skuName.(string), "DW") && (d.HasChange("geo_backup_enabled") || d.IsNewResource()) {
 		isEnabled := d.Get("geo_backup_enabled").(bool)
@@ -750,8 +887,20 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			skuName = *props.CurrentServiceObjectiveName
 		}
 		d.Set("sku_name", skuName)
+		d.Set("secondary_type", string(props.SecondaryType))
 		d.Set("storage_account_type", flattenMsSqlBackupStorageRedundancy(props.CurrentBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
+		d.Set("ledger_enabled", props.IsLedgerOn)
+
+		maintenanceConfigurationName := ""
+		if props.MaintenanceConfigurationID != nil {
+			maintenanceConfigId, err := maintenanceParse.PublicMaintenanceConfigurationID(*props.MaintenanceConfigurationID)
+			if err != nil {
+				return err
+			}
+			maintenanceConfigurationName = maintenanceConfigId.Name
+		}
+		d.Set("maintenance_configuration_name", maintenanceConfigurationName)
 	}
 
 	securityAlertPolicy, err := securityAlertPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
@@ -956,6 +1105,31 @@ func expandMsSqlServerSecurityAlertPolicy(d *pluginsdk.ResourceData) sql.Databas
 	return policy
 }
 
+func expandMsSqlDatabaseImport(input []interface{}, id parse.DatabaseId) sql.ImportExistingDatabaseDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return sql.ImportExistingDatabaseDefinition{}
+	}
+	v := input[0].(map[string]interface{})
+
+	importDefinition := sql.ImportExistingDatabaseDefinition{
+		StorageKeyType:             sql.StorageKeyType(v["storage_key_type"].(string)),
+		StorageKey:                 utils.String(v["storage_key"].(string)),
+		StorageURI:                 utils.String(v["storage_uri"].(string)),
+		AdministratorLogin:         utils.String(v["administrator_login"].(string)),
+		AdministratorLoginPassword: utils.String(v["administrator_login_password"].(string)),
+		AuthenticationType:         utils.String(v["authentication_type"].(string)),
+	}
+
+	if storageAccountId := v["storage_account_id"].(string); storageAccountId != "" {
+		importDefinition.NetworkIsolation = &sql.NetworkIsolationSettings{
+			StorageAccountResourceID: utils.String(storageAccountId),
+			SQLServerResourceID:      utils.String(parse.NewServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName).ID()),
+		}
+	}
+
+	return importDefinition
+}
+
 // TODO - 3.0: change output to API enums
 func flattenMsSqlBackupStorageRedundancy(currentBackupStorageRedundancy sql.CurrentBackupStorageRedundancy) string {
 	switch currentBackupStorageRedundancy {
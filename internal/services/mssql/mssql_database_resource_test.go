@@ -311,6 +311,23 @@ func TestAccMsSqlDatabase_createOnlineSecondaryMode(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_createNamedReplica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "secondary")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createNamedReplica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Named"),
+				check.That(data.ResourceName).Key("sku_name").HasValue("HS_Gen5_2"),
+			),
+		},
+		data.ImportStep("create_mode", "creation_source_database_id"),
+	})
+}
+
 func TestAccMsSqlDatabase_scaleReplicaSet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "primary")
 	r := MsSqlDatabaseResource{}
@@ -465,6 +482,53 @@ func TestAccMsSqlDatabase_storageAccountType(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_maintenanceConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.maintenanceConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_configuration_name").HasValue("SQL_Default"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabase_ledgerEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ledgerEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ledger_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabase_bacpac(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.bacpac(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("import"),
+	})
+}
+
 func TestAccMsSqlDatabase_threatDetectionPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
 	r := MsSqlDatabaseResource{}
@@ -804,6 +868,82 @@ resource "azurerm_mssql_database" "import" {
 `, r.basic(data))
 }
 
+func (r MsSqlDatabaseResource) maintenanceConfiguration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name                           = "acctest-db-%[2]d"
+  server_id                      = azurerm_mssql_server.test.id
+  sku_name                       = "GP_Gen5_2"
+  maintenance_configuration_name = "SQL_Default"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) ledgerEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name           = "acctest-db-%[2]d"
+  server_id      = azurerm_mssql_server.test.id
+  ledger_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) bacpac(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "bacpac"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "test.bacpac"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "testdata/sql_import.bacpac"
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "allowazure"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[3]d"
+  server_id = azurerm_mssql_server.test.id
+
+  import {
+    storage_uri                  = azurerm_storage_blob.test.url
+    storage_key                  = azurerm_storage_account.test.primary_access_key
+    storage_key_type             = "StorageAccessKey"
+    administrator_login          = azurerm_mssql_server.test.administrator_login
+    administrator_login_password = azurerm_mssql_server.test.administrator_login_password
+    authentication_type          = "SQL"
+  }
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
 func (r MsSqlDatabaseResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -1030,6 +1170,27 @@ resource "azurerm_mssql_database" "pitr" {
 `, r.basic(data), data.RandomInteger, time.Now().Add(time.Duration(7)*time.Minute).UTC().Format(time.RFC3339))
 }
 
+func (r MsSqlDatabaseResource) createNamedReplica(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "HS_Gen5_2"
+}
+
+resource "azurerm_mssql_database" "secondary" {
+  name                        = "acctest-dbnr-%[2]d"
+  server_id                   = azurerm_mssql_server.test.id
+  create_mode                 = "Secondary"
+  secondary_type              = "Named"
+  creation_source_database_id = azurerm_mssql_database.test.id
+  sku_name                    = "HS_Gen5_2"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MsSqlDatabaseResource) createSecondaryMode(data acceptance.TestData, tag string) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -94,6 +95,11 @@ func dataSourceMsSqlElasticpool() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"maintenance_configuration_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -134,6 +140,16 @@ func dataSourceMsSqlElasticpoolRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("zone_redundant", props.ZoneRedundant)
 		d.Set("license_type", props.LicenseType)
 
+		maintenanceConfigurationName := ""
+		if props.MaintenanceConfigurationID != nil {
+			maintenanceConfigId, err := maintenanceParse.PublicMaintenanceConfigurationID(*props.MaintenanceConfigurationID)
+			if err != nil {
+				return err
+			}
+			maintenanceConfigurationName = maintenanceConfigId.Name
+		}
+		d.Set("maintenance_configuration_name", maintenanceConfigurationName)
+
 		if perDbSettings := props.PerDatabaseSettings; perDbSettings != nil {
 			d.Set("per_db_min_capacity", perDbSettings.MinCapacity)
 			d.Set("per_db_max_capacity", perDbSettings.MaxCapacity)
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -160,6 +161,13 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"maintenance_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.DatabaseMaintenanceConfigurationName,
+			},
+
 			"license_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -223,6 +231,11 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		},
 	}
 
+	if v, ok := d.GetOk("maintenance_configuration_name"); ok {
+		subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+		elasticPool.ElasticPoolProperties.MaintenanceConfigurationID = utils.String(maintenanceParse.NewPublicMaintenanceConfigurationID(subscriptionId, v.(string)).ID())
+	}
+
 	if d.HasChange("max_size_gb") {
 		if v, ok := d.GetOk("max_size_gb"); ok {
 			maxSizeBytes := v.(float64) * 1073741824
@@ -298,6 +311,16 @@ func resourceMsSqlElasticPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("zone_redundant", properties.ZoneRedundant)
 		d.Set("license_type", string(properties.LicenseType))
 
+		maintenanceConfigurationName := ""
+		if properties.MaintenanceConfigurationID != nil {
+			maintenanceConfigId, err := maintenanceParse.PublicMaintenanceConfigurationID(*properties.MaintenanceConfigurationID)
+			if err != nil {
+				return err
+			}
+			maintenanceConfigurationName = maintenanceConfigId.Name
+		}
+		d.Set("maintenance_configuration_name", maintenanceConfigurationName)
+
 		if err := d.Set("per_database_settings", flattenMsSqlElasticPoolPerDatabaseSettings(properties.PerDatabaseSettings)); err != nil {
 			return fmt.Errorf("setting `per_database_settings`: %+v", err)
 		}
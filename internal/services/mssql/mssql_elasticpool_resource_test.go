@@ -220,6 +220,30 @@ func TestAccMsSqlElasticPool_licenseType(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_maintenanceConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.maintenanceConfiguration(data, "SQL_Default"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttr(data.ResourceName, "maintenance_configuration_name", "SQL_Default"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+		{
+			Config: r.maintenanceConfiguration(data, "SQL_WestEurope_DB_2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttr(data.ResourceName, "maintenance_configuration_name", "SQL_WestEurope_DB_2"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
 func (MsSqlElasticPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ElasticPoolID(state.ID)
 	if err != nil {
@@ -472,3 +496,47 @@ resource "azurerm_mssql_elasticpool" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, licenseType)
 }
+
+func (MsSqlElasticPoolResource) maintenanceConfiguration(data acceptance.TestData, maintenanceConfigurationName string) string {
+	// public maintenance configurations are region specific, so this test is pinned to West Europe
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "westeurope"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                           = "acctest-pool-vcore-%[1]d"
+  resource_group_name            = azurerm_resource_group.test.name
+  location                       = azurerm_resource_group.test.location
+  server_name                    = azurerm_sql_server.test.name
+  max_size_gb                    = 5
+  maintenance_configuration_name = "%[2]s"
+
+  sku {
+    name     = "GP_Gen5"
+    tier     = "GeneralPurpose"
+    capacity = 4
+    family   = "Gen5"
+  }
+
+  per_database_settings {
+    min_capacity = 0.25
+    max_capacity = 4
+  }
+}
+`, data.RandomInteger, maintenanceConfigurationName)
+}
@@ -195,6 +195,12 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"outbound_network_restriction_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"extended_auditing_policy": helper.ExtendedAuditingSchema(),
 
 			"fully_qualified_domain_name": {
@@ -272,6 +278,11 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.ServerProperties.PublicNetworkAccess = sql.ServerNetworkAccessFlagDisabled
 	}
 
+	props.ServerProperties.RestrictOutboundNetworkAccess = sql.ServerNetworkAccessFlagDisabled
+	if d.Get("outbound_network_restriction_enabled").(bool) {
+		props.ServerProperties.RestrictOutboundNetworkAccess = sql.ServerNetworkAccessFlagEnabled
+	}
+
 	if d.HasChange("administrator_login_password") {
 		adminPassword := d.Get("administrator_login_password").(string)
 		props.ServerProperties.AdministratorLoginPassword = utils.String(adminPassword)
@@ -367,6 +378,11 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.ServerProperties.PublicNetworkAccess = sql.ServerNetworkAccessFlagDisabled
 	}
 
+	props.ServerProperties.RestrictOutboundNetworkAccess = sql.ServerNetworkAccessFlagDisabled
+	if d.Get("outbound_network_restriction_enabled").(bool) {
+		props.ServerProperties.RestrictOutboundNetworkAccess = sql.ServerNetworkAccessFlagEnabled
+	}
+
 	if d.HasChange("administrator_login_password") {
 		adminPassword := d.Get("administrator_login_password").(string)
 		props.ServerProperties.AdministratorLoginPassword = utils.String(adminPassword)
@@ -470,6 +486,7 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 	auditingClient := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
 	connectionClient := meta.(*clients.Client).MSSQL.ServerConnectionPoliciesClient
 	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL.RestorableDroppedDatabasesClient
+	aadOnlyAuthentictionsClient := meta.(*clients.Client).MSSQL.ServerAzureADOnlyAuthenticationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -509,6 +526,7 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("fully_qualified_domain_name", props.FullyQualifiedDomainName)
 		d.Set("minimum_tls_version", props.MinimalTLSVersion)
 		d.Set("public_network_access_enabled", props.PublicNetworkAccess == sql.ServerNetworkAccessFlagEnabled)
+		d.Set("outbound_network_restriction_enabled", props.RestrictOutboundNetworkAccess == sql.ServerNetworkAccessFlagEnabled)
 		primaryUserAssignedIdentityID := ""
 		if props.PrimaryUserAssignedIdentityID != nil && *props.PrimaryUserAssignedIdentityID != "" {
 			parsedPrimaryUserAssignedIdentityID, err := msiparse.UserAssignedIdentityIDInsensitively(*props.PrimaryUserAssignedIdentityID)
@@ -519,9 +537,21 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 		d.Set("primary_user_assigned_identity_id", primaryUserAssignedIdentityID)
 		if props.Administrators != nil {
-			d.Set("azuread_administrator", flatternMsSqlServerAdministrators(*props.Administrators))
-		}
+			administrators := flatternMsSqlServerAdministrators(*props.Administrators)
 
+			// the value embedded in the server's administrators is read-only and can lag behind changes made
+			// through the dedicated endpoint, so use that as the source of truth to detect drift
+			aadOnlyAuthentictionsResp, err := aadOnlyAuthentictionsClient.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(aadOnlyAuthentictionsResp.Response) {
+					return fmt.Errorf("retrieving AAD only authentication for %s: %+v", id.String(), err)
+				}
+			} else if aadOnlyProps := aadOnlyAuthentictionsResp.AzureADOnlyAuthProperties; aadOnlyProps != nil && aadOnlyProps.AzureADOnlyAuthentication != nil {
+				administrators[0].(map[string]interface{})["azuread_authentication_only"] = *aadOnlyProps.AzureADOnlyAuthentication
+			}
+
+			d.Set("azuread_administrator", administrators)
+		}
 	}
 
 	connection, err := connectionClient.Get(ctx, id.ResourceGroup, id.Name)
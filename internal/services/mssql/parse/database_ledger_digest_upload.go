@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DatabaseLedgerDigestUploadId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ServerName             string
+	DatabaseName           string
+	LedgerDigestUploadName string
+}
+
+func NewDatabaseLedgerDigestUploadID(subscriptionId, resourceGroup, serverName, databaseName, ledgerDigestUploadName string) DatabaseLedgerDigestUploadId {
+	return DatabaseLedgerDigestUploadId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ServerName:             serverName,
+		DatabaseName:           databaseName,
+		LedgerDigestUploadName: ledgerDigestUploadName,
+	}
+}
+
+func (id DatabaseLedgerDigestUploadId) String() string {
+	segments := []string{
+		fmt.Sprintf("Ledger Digest Upload Name %q", id.LedgerDigestUploadName),
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Database Ledger Digest Upload", segmentsStr)
+}
+
+func (id DatabaseLedgerDigestUploadId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/ledgerDigestUploads/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.LedgerDigestUploadName)
+}
+
+// DatabaseLedgerDigestUploadID parses a DatabaseLedgerDigestUpload ID into an DatabaseLedgerDigestUploadId struct
+func DatabaseLedgerDigestUploadID(input string) (*DatabaseLedgerDigestUploadId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseLedgerDigestUploadId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.LedgerDigestUploadName, err = id.PopSegment("ledgerDigestUploads"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
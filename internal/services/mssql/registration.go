@@ -36,11 +36,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_mssql_database":                                        resourceMsSqlDatabase(),
 		"azurerm_mssql_database_extended_auditing_policy":               resourceMsSqlDatabaseExtendedAuditingPolicy(),
+		"azurerm_mssql_database_ledger_digest_upload":                   resourceMsSqlDatabaseLedgerDigestUpload(),
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
+		"azurerm_mssql_outbound_firewall_rule":                          resourceMsSqlOutboundFirewallRule(),
 		"azurerm_mssql_server":                                          resourceMsSqlServer(),
 		"azurerm_mssql_server_extended_auditing_policy":                 resourceMsSqlServerExtendedAuditingPolicy(),
 		"azurerm_mssql_server_security_alert_policy":                    resourceMsSqlServerSecurityAlertPolicy(),
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DatabaseMaintenanceConfigurationName validates the name of a public maintenance configuration
+// which can be used with Databases and Elastic Pools, e.g. `SQL_Default` or `SQL_WestEurope_DB_1`
+func DatabaseMaintenanceConfigurationName(v interface{}, k string) (warnings []string, errors []error) {
+	return maintenanceConfigurationName(v, k, "DB")
+}
+
+// ManagedInstanceMaintenanceConfigurationName validates the name of a public maintenance configuration
+// which can be used with Managed Instances, e.g. `SQL_Default` or `SQL_WestEurope_MI_1`
+func ManagedInstanceMaintenanceConfigurationName(v interface{}, k string) (warnings []string, errors []error) {
+	return maintenanceConfigurationName(v, k, "MI")
+}
+
+func maintenanceConfigurationName(v interface{}, k string, scope string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if value == "SQL_Default" {
+		return
+	}
+
+	if !regexp.MustCompile(fmt.Sprintf(`^SQL_[A-Za-z0-9]+_%s_[1-9]$`, scope)).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be either `SQL_Default` or in the format `SQL_{Region}_%s_{Number}`, got %q", k, scope, value))
+	}
+
+	return
+}
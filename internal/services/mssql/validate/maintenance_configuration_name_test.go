@@ -0,0 +1,86 @@
+package validate
+
+import "testing"
+
+func TestDatabaseMaintenanceConfigurationName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			valid: false,
+		},
+		{
+			name:  "Default",
+			input: "SQL_Default",
+			valid: true,
+		},
+		{
+			name:  "Regional Database",
+			input: "SQL_WestEurope_DB_1",
+			valid: true,
+		},
+		{
+			name:  "Regional Database with number",
+			input: "SQL_EastUS2_DB_2",
+			valid: true,
+		},
+		{
+			name:  "Regional Managed Instance",
+			input: "SQL_WestEurope_MI_1",
+			valid: false,
+		},
+		{
+			name:  "Missing Region",
+			input: "SQL_DB_1",
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Logf("[DEBUG] Testing %q..", tt.name)
+
+		_, errors := DatabaseMaintenanceConfigurationName(tt.input, "maintenance_configuration_name")
+		valid := len(errors) == 0
+		if tt.valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tt.valid, valid, tt.input)
+		}
+	}
+}
+
+func TestManagedInstanceMaintenanceConfigurationName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Default",
+			input: "SQL_Default",
+			valid: true,
+		},
+		{
+			name:  "Regional Managed Instance",
+			input: "SQL_WestEurope_MI_1",
+			valid: true,
+		},
+		{
+			name:  "Regional Database",
+			input: "SQL_WestEurope_DB_1",
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Logf("[DEBUG] Testing %q..", tt.name)
+
+		_, errors := ManagedInstanceMaintenanceConfigurationName(tt.input, "maintenance_configuration_name")
+		valid := len(errors) == 0
+		if tt.valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tt.valid, valid, tt.input)
+		}
+	}
+}
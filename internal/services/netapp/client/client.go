@@ -6,16 +6,20 @@ import (
 )
 
 type Client struct {
-	AccountClient  *netapp.AccountsClient
-	PoolClient     *netapp.PoolsClient
-	VolumeClient   *netapp.VolumesClient
-	SnapshotClient *netapp.SnapshotsClient
+	AccountClient      *netapp.AccountsClient
+	BackupPolicyClient *netapp.BackupPoliciesClient
+	PoolClient         *netapp.PoolsClient
+	VolumeClient       *netapp.VolumesClient
+	SnapshotClient     *netapp.SnapshotsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountClient := netapp.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
 
+	backupPolicyClient := netapp.NewBackupPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&backupPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	poolClient := netapp.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&poolClient.Client, o.ResourceManagerAuthorizer)
 
@@ -26,9 +30,10 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&snapshotClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:  &accountClient,
-		PoolClient:     &poolClient,
-		VolumeClient:   &volumeClient,
-		SnapshotClient: &snapshotClient,
+		AccountClient:      &accountClient,
+		BackupPolicyClient: &backupPolicyClient,
+		PoolClient:         &poolClient,
+		VolumeClient:       &volumeClient,
+		SnapshotClient:     &snapshotClient,
 	}
 }
@@ -0,0 +1,163 @@
+package netapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetAppBackupPolicyResource struct {
+}
+
+func TestAccNetAppBackupPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_backup_policy", "test")
+	r := NetAppBackupPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetAppBackupPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_backup_policy", "test")
+	r := NetAppBackupPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_netapp_backup_policy"),
+		},
+	})
+}
+
+func TestAccNetAppBackupPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_backup_policy", "test")
+	r := NetAppBackupPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("daily_backups_to_keep").HasValue("10"),
+				check.That(data.ResourceName).Key("weekly_backups_to_keep").HasValue("4"),
+				check.That(data.ResourceName).Key("monthly_backups_to_keep").HasValue("12"),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t NetAppBackupPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackupPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.NetApp.BackupPolicyClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r NetAppBackupPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_netapp_backup_policy" "test" {
+  name                = "acctest-NetAppBackupPolicy-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetAppBackupPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_netapp_backup_policy" "import" {
+  name                = azurerm_netapp_backup_policy.test.name
+  location            = azurerm_netapp_backup_policy.test.location
+  resource_group_name = azurerm_netapp_backup_policy.test.resource_group_name
+  account_name        = azurerm_netapp_backup_policy.test.account_name
+}
+`, r.basic(data))
+}
+
+func (r NetAppBackupPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_netapp_backup_policy" "test" {
+  name                    = "acctest-NetAppBackupPolicy-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  account_name            = azurerm_netapp_account.test.name
+  daily_backups_to_keep   = 10
+  weekly_backups_to_keep  = 4
+  monthly_backups_to_keep = 12
+  enabled                 = false
+
+  tags = {
+    "FoO" = "BaR"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (NetAppBackupPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-netapp-%d"
+  location = "%s"
+}
+
+resource "azurerm_netapp_account" "test" {
+  name                = "acctest-NetAppAccount-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
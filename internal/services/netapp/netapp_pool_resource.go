@@ -84,6 +84,13 @@ func resourceNetAppPool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"cool_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -119,6 +126,7 @@ func resourceNetAppPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		PoolProperties: &netapp.PoolProperties{
 			ServiceLevel: netapp.ServiceLevel(serviceLevel),
 			Size:         utils.Int64(sizeInBytes),
+			CoolAccess:   utils.Bool(d.Get("cool_access_enabled").(bool)),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -177,6 +185,7 @@ func resourceNetAppPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 		d.Set("size_in_tb", int(sizeInTB))
 		d.Set("qos_type", string(poolProperties.QosType))
+		d.Set("cool_access_enabled", poolProperties.CoolAccess != nil && *poolProperties.CoolAccess)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -211,6 +211,21 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				},
 			},
 
+			"cool_access": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"coolness_period_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 63),
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"mount_ip_addresses": {
@@ -408,10 +423,17 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 			SnapshotID:               utils.String(snapshotID),
 			DataProtection:           dataProtectionReplication,
 			SnapshotDirectoryVisible: utils.Bool(snapshotDirectoryVisible),
+			CoolAccess:               utils.Bool(false),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v := d.Get("cool_access").([]interface{}); len(v) > 0 && v[0] != nil {
+		coolAccess := v[0].(map[string]interface{})
+		parameters.VolumeProperties.CoolAccess = utils.Bool(true)
+		parameters.VolumeProperties.CoolnessPeriod = utils.Int32(int32(coolAccess["coolness_period_in_days"].(int)))
+	}
+
 	if throughputMibps, ok := d.GetOk("throughput_in_mibps"); ok {
 		parameters.VolumeProperties.ThroughputMibps = utils.Float(throughputMibps.(float64))
 	}
@@ -516,6 +538,10 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 
 		d.Set("throughput_in_mibps", props.ThroughputMibps)
+
+		if err := d.Set("cool_access", flattenNetAppVolumeCoolAccess(props.CoolAccess, props.CoolnessPeriod)); err != nil {
+			return fmt.Errorf("setting `cool_access`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -968,6 +994,23 @@ func flattenNetAppVolumeDataProtectionReplication(input *netapp.VolumeProperties
 	}
 }
 
+func flattenNetAppVolumeCoolAccess(coolAccess *bool, coolnessPeriod *int32) []interface{} {
+	if coolAccess == nil || !*coolAccess {
+		return []interface{}{}
+	}
+
+	coolnessPeriodInDays := 0
+	if coolnessPeriod != nil {
+		coolnessPeriodInDays = int(*coolnessPeriod)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"coolness_period_in_days": coolnessPeriodInDays,
+		},
+	}
+}
+
 func translateTFSchedule(scheduleName string) string {
 	if strings.EqualFold(scheduleName, "10minutes") {
 		return "_10minutely"
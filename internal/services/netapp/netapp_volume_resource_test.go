@@ -231,6 +231,28 @@ func TestAccNetAppVolume_updateExportPolicyRule(t *testing.T) {
 	})
 }
 
+func TestAccNetAppVolume_coolAccess(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.coolAccess(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.coolAccess(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t NetAppVolumeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VolumeID(state.ID)
 	if err != nil {
@@ -577,6 +599,39 @@ resource "azurerm_netapp_volume" "test" {
 `, r.template(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (NetAppVolumeResource) coolAccess(data acceptance.TestData, coolnessPeriod int) string {
+	template := NetAppVolumeResource{}.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_netapp_pool" "test_cool" {
+  name                = "acctest-NetAppPool-cool-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  service_level       = "Standard"
+  size_in_tb          = 4
+  cool_access_enabled = true
+}
+
+resource "azurerm_netapp_volume" "test" {
+  name                = "acctest-NetAppVolume-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test_cool.name
+  volume_path         = "my-unique-file-path-%[2]d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  storage_quota_in_gb = 100
+
+  cool_access {
+    coolness_period_in_days = %[3]d
+  }
+}
+`, template, data.RandomInteger, coolnessPeriod)
+}
+
 func (r NetAppVolumeResource) templateForCrossRegionReplication(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,224 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayHTTPListenerFirewallPolicyAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationCreate,
+		Read:   resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationRead,
+		Delete: resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, _, err := parseApplicationGatewayHTTPListenerFirewallPolicyAssociationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"http_listener_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayHTTPListenerID,
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayWebApplicationFirewallPolicyID,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Application Gateway HTTP Listener <-> Firewall Policy Association creation.")
+
+	listenerId, err := parse.ApplicationGatewayHTTPListenerID(d.Get("http_listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	policyId, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(listenerId.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(listenerId.ApplicationGatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, listenerId.ResourceGroup, listenerId.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("Application Gateway %q (Resource Group %q) was not found", listenerId.ApplicationGatewayName, listenerId.ResourceGroup)
+		}
+
+		return fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", listenerId.ApplicationGatewayName, listenerId.ResourceGroup, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, listenerId.HttpListenerName)
+	if listener == nil {
+		return fmt.Errorf("%s was not found", *listenerId)
+	}
+
+	resourceId := fmt.Sprintf("%s|%s", listenerId.ID(), policyId.ID())
+	if listener.FirewallPolicy != nil && listener.FirewallPolicy.ID != nil && *listener.FirewallPolicy.ID != "" {
+		if strings.EqualFold(*listener.FirewallPolicy.ID, policyId.ID()) {
+			return tf.ImportAsExistsError("azurerm_application_gateway_http_listener_firewall_policy_association", resourceId)
+		}
+
+		return fmt.Errorf("%s is already associated with the Firewall Policy %q", *listenerId, *listener.FirewallPolicy.ID)
+	}
+
+	listener.FirewallPolicy = &network.SubResource{
+		ID: utils.String(policyId.ID()),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, listenerId.ResourceGroup, listenerId.ApplicationGatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("associating %s with %s: %+v", *listenerId, *policyId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for association of %s with %s: %+v", *listenerId, *policyId, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	listenerId, policyId, err := parseApplicationGatewayHTTPListenerFirewallPolicyAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, listenerId.ResourceGroup, listenerId.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway %q (Resource Group %q) was not found - removing from state!", listenerId.ApplicationGatewayName, listenerId.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", listenerId.ApplicationGatewayName, listenerId.ResourceGroup, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, listenerId.HttpListenerName)
+	if listener == nil || listener.FirewallPolicy == nil || listener.FirewallPolicy.ID == nil || !strings.EqualFold(*listener.FirewallPolicy.ID, policyId.ID()) {
+		log.Printf("[DEBUG] Association between %s and %s was not found - removing from state!", *listenerId, *policyId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("http_listener_id", listenerId.ID())
+	d.Set("firewall_policy_id", policyId.ID())
+
+	return nil
+}
+
+func resourceApplicationGatewayHTTPListenerFirewallPolicyAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	listenerId, policyId, err := parseApplicationGatewayHTTPListenerFirewallPolicyAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(listenerId.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(listenerId.ApplicationGatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, listenerId.ResourceGroup, listenerId.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", listenerId.ApplicationGatewayName, listenerId.ResourceGroup, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, listenerId.HttpListenerName)
+	if listener == nil || listener.FirewallPolicy == nil || listener.FirewallPolicy.ID == nil || !strings.EqualFold(*listener.FirewallPolicy.ID, policyId.ID()) {
+		return nil
+	}
+
+	listener.FirewallPolicy = nil
+
+	future, err := client.CreateOrUpdate(ctx, listenerId.ResourceGroup, listenerId.ApplicationGatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("removing association of %s with %s: %+v", *listenerId, *policyId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for removal of association of %s with %s: %+v", *listenerId, *policyId, err)
+	}
+
+	return nil
+}
+
+func parseApplicationGatewayHTTPListenerFirewallPolicyAssociationID(input string) (*parse.ApplicationGatewayHTTPListenerId, *parse.ApplicationGatewayWebApplicationFirewallPolicyId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, nil, fmt.Errorf("expected ID to be in the format {httpListenerId}|{firewallPolicyId} but got %q", input)
+	}
+
+	listenerId, err := parse.ApplicationGatewayHTTPListenerID(splitId[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyId, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(splitId[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return listenerId, policyId, nil
+}
+
+// findApplicationGatewayHTTPListener returns the properties of the named HTTP Listener, which can be modified in place
+func findApplicationGatewayHTTPListener(gateway network.ApplicationGateway, name string) *network.ApplicationGatewayHTTPListenerPropertiesFormat {
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.HTTPListeners == nil {
+		return nil
+	}
+
+	listeners := *props.HTTPListeners
+	for i := range listeners {
+		if listeners[i].Name != nil && strings.EqualFold(*listeners[i].Name, name) {
+			return listeners[i].ApplicationGatewayHTTPListenerPropertiesFormat
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource struct {
+}
+
+func TestAccApplicationGatewayHTTPListenerFirewallPolicyAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener_firewall_policy_association", "test")
+	r := ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListenerFirewallPolicyAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener_firewall_policy_association", "test")
+	r := ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_application_gateway_http_listener_firewall_policy_association"),
+		},
+	})
+}
+
+func TestAccApplicationGatewayHTTPListenerFirewallPolicyAssociation_updateGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener_firewall_policy_association", "test")
+	r := ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// updating the Application Gateway mustn't remove the association
+			Config: r.updatedGateway(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// confirm that neither resource wants to change the association
+			Config:   r.updatedGateway(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func (ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	splitId := strings.Split(state.ID, "|")
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {httpListenerId}|{firewallPolicyId} but got %q", state.ID)
+	}
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(splitId[0])
+	if err != nil {
+		return nil, err
+	}
+
+	firewallPolicyId := splitId[1]
+
+	read, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("reading ApplicationGatewayHTTPListenerFirewallPolicyAssociation (%s): %+v", id, err)
+	}
+
+	props := read.ApplicationGatewayPropertiesFormat
+	if props == nil || props.HTTPListeners == nil {
+		return nil, fmt.Errorf("`properties.HTTPListeners` was nil for (%s)", id)
+	}
+
+	found := false
+	for _, listener := range *props.HTTPListeners {
+		if listener.Name == nil || !strings.EqualFold(*listener.Name, id.HttpListenerName) {
+			continue
+		}
+
+		if listenerProps := listener.ApplicationGatewayHTTPListenerPropertiesFormat; listenerProps != nil {
+			if policy := listenerProps.FirewallPolicy; policy != nil && policy.ID != nil {
+				found = strings.EqualFold(*policy.ID, firewallPolicyId)
+			}
+		}
+		break
+	}
+
+	return utils.Bool(found), nil
+}
+
+func (r ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource) basic(data acceptance.TestData) string {
+	return r.template(data, "")
+}
+
+func (r ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener_firewall_policy_association" "import" {
+  http_listener_id   = azurerm_application_gateway_http_listener_firewall_policy_association.test.http_listener_id
+  firewall_policy_id = azurerm_application_gateway_http_listener_firewall_policy_association.test.firewall_policy_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource) updatedGateway(data acceptance.TestData) string {
+	return r.template(data, `
+  tags = {
+    environment = "Test"
+  }
+`)
+}
+
+// the Application Gateway ignores changes to the HTTP Listeners, since the association is managed outside of it
+func (ApplicationGatewayHTTPListenerFirewallPolicyAssociationResource) template(data acceptance.TestData, tags string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+}
+
+resource "azurerm_public_ip" "teststd" {
+  name                = "acctest-PubIpStd-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_web_application_firewall_policy" "testfwp" {
+  name                = "acctest-fwp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_settings {
+    enabled                     = true
+    mode                        = "Prevention"
+    file_upload_limit_in_mb     = 100
+    max_request_body_size_in_kb = 100
+    request_body_check          = "true"
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.1"
+    }
+  }
+}
+
+resource "azurerm_web_application_firewall_policy" "listener" {
+  name                = "acctest-fwp-listener-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_settings {
+    enabled = true
+    mode    = "Detection"
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.1"
+    }
+  }
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "WAF_v2"
+    tier     = "WAF_v2"
+    capacity = 2
+  }
+
+  firewall_policy_id = azurerm_web_application_firewall_policy.testfwp.id
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.teststd.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 443
+    protocol              = "Https"
+    request_timeout       = 1
+
+    pick_host_name_from_backend_address = true
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+%[3]s
+  lifecycle {
+    ignore_changes = [http_listener]
+  }
+}
+
+resource "azurerm_application_gateway_http_listener_firewall_policy_association" "test" {
+  http_listener_id   = "${azurerm_application_gateway.test.id}/httpListeners/${local.listener_name}"
+  firewall_policy_id = azurerm_web_application_firewall_policy.listener.id
+}
+`, ApplicationGatewayResource{}.template(data), data.RandomInteger, tags)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 // See https://github.com/Azure/azure-sdk-for-go/blob/master/services/network/mgmt/2018-04-01/network/models.go
 func possibleApplicationGatewaySslCipherSuiteValues() []string {
 	cipherSuites := make([]string, 0)
@@ -1400,6 +1403,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"force_firewall_policy_association": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"custom_error_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1443,6 +1452,10 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	id := parse.NewApplicationGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1584,6 +1597,8 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	gateway.ApplicationGatewayPropertiesFormat.ForceFirewallPolicyAssociation = utils.Bool(d.Get("force_firewall_policy_association").(bool))
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1763,6 +1778,8 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `url_path_map`: %+v", setErr)
 		}
 
+		d.Set("force_firewall_policy_association", utils.NormaliseNilableBool(props.ForceFirewallPolicyAssociation))
+
 		if setErr := d.Set("waf_configuration", flattenApplicationGatewayWafConfig(props.WebApplicationFirewallConfiguration)); setErr != nil {
 			return fmt.Errorf("setting `waf_configuration`: %+v", setErr)
 		}
@@ -4186,6 +4203,16 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 		return err
 	}
 
+	if d.Get("force_firewall_policy_association").(bool) {
+		if _, ok := d.GetOk("waf_configuration"); ok {
+			return fmt.Errorf("`waf_configuration` cannot be specified when `force_firewall_policy_association` is enabled, the WAF settings must be configured in the Firewall Policy instead")
+		}
+
+		if d.Get("firewall_policy_id").(string) == "" && d.NewValueKnown("firewall_policy_id") {
+			return fmt.Errorf("`firewall_policy_id` must be specified when `force_firewall_policy_association` is enabled")
+		}
+	}
+
 	sslProfiles := d.Get("ssl_profile").([]interface{})
 	if len(sslProfiles) > 0 {
 		for _, profile := range sslProfiles {
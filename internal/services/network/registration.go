@@ -73,6 +73,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                        resourceNetworkInterface(),
 
+		"azurerm_application_gateway_http_listener_firewall_policy_association":          resourceApplicationGatewayHTTPListenerFirewallPolicyAssociation(),
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
 		"azurerm_network_interface_backend_address_pool_association":                     resourceNetworkInterfaceBackendAddressPoolAssociation(),
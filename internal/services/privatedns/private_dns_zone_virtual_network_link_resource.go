@@ -114,13 +114,20 @@ func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceD
 	etag := ""
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 
+	// a Virtual Network can only be linked with registration enabled to a single Private DNS Zone, which the API
+	// rejects with a generic error - so this is called out, rather than searching the existing links up front
+	registrationHint := ""
+	if registrationEnabled {
+		registrationHint = fmt.Sprintf("\n\nNote: a Virtual Network can only be linked to a single Private DNS Zone with `registration_enabled` set to `true` - if %q is already linked to another Private DNS Zone with registration enabled, registration must be disabled on that link first", vNetID)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.PrivateDnsZoneName, resourceId.Name, parameters, etag, ifNoneMatch)
 	if err != nil {
-		return fmt.Errorf("creating/updating Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", resourceId.Name, resourceId.PrivateDnsZoneName, resourceId.ResourceGroup, err)
+		return fmt.Errorf("creating/updating Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v%s", resourceId.Name, resourceId.PrivateDnsZoneName, resourceId.ResourceGroup, err, registrationHint)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) to become available: %+v", resourceId.Name, resourceId.PrivateDnsZoneName, resourceId.ResourceGroup, err)
+		return fmt.Errorf("waiting for Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) to become available: %+v%s", resourceId.Name, resourceId.PrivateDnsZoneName, resourceId.ResourceGroup, err, registrationHint)
 	}
 
 	d.SetId(resourceId.ID())
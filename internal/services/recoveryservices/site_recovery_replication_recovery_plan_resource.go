@@ -0,0 +1,673 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-07-10/siterecovery"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSiteRecoveryReplicationRecoveryPlan() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSiteRecoveryReplicationRecoveryPlanCreate,
+		Read:   resourceSiteRecoveryReplicationRecoveryPlanRead,
+		Update: resourceSiteRecoveryReplicationRecoveryPlanUpdate,
+		Delete: resourceSiteRecoveryReplicationRecoveryPlanDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ReplicationRecoveryPlanID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_recovery_fabric_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ReplicationFabricID,
+			},
+
+			"target_recovery_fabric_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ReplicationFabricID,
+			},
+
+			"failover_deployment_model": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(siterecovery.NotApplicable),
+					string(siterecovery.ResourceManager),
+				}, false),
+			},
+
+			"shutdown_recovery_group": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"pre_action":  schemaSiteRecoveryReplicationRecoveryPlanActions(),
+						"post_action": schemaSiteRecoveryReplicationRecoveryPlanActions(),
+					},
+				},
+			},
+
+			"failover_recovery_group": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"pre_action":  schemaSiteRecoveryReplicationRecoveryPlanActions(),
+						"post_action": schemaSiteRecoveryReplicationRecoveryPlanActions(),
+					},
+				},
+			},
+
+			"boot_recovery_group": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"replicated_protected_items": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.ReplicationProtectedItemID,
+							},
+						},
+
+						"pre_action":  schemaSiteRecoveryReplicationRecoveryPlanActions(),
+						"post_action": schemaSiteRecoveryReplicationRecoveryPlanActions(),
+					},
+				},
+			},
+
+			"azure_to_azure_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"primary_zone": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"azure_to_azure_settings.0.recovery_zone"},
+						},
+
+						"recovery_zone": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"azure_to_azure_settings.0.primary_zone"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaSiteRecoveryReplicationRecoveryPlanActions() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.InstanceTypeAutomationRunbookActionDetails),
+						string(siterecovery.InstanceTypeManualActionDetails),
+						string(siterecovery.InstanceTypeScriptActionDetails),
+					}, false),
+				},
+
+				"fail_over_directions": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.PrimaryToRecovery),
+							string(siterecovery.RecoveryToPrimary),
+						}, false),
+					},
+				},
+
+				"fail_over_types": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.ReplicationProtectedItemOperationPlannedFailover),
+							string(siterecovery.ReplicationProtectedItemOperationTestFailover),
+							string(siterecovery.ReplicationProtectedItemOperationUnplannedFailover),
+						}, false),
+					},
+				},
+
+				"fabric_location": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.Primary),
+						string(siterecovery.Recovery),
+					}, false),
+				},
+
+				"runbook_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"manual_action_instruction": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"script_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceSiteRecoveryReplicationRecoveryPlanCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	id := parse.NewReplicationRecoveryPlanID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), d.Get("name").(string))
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(id.ResourceGroup, id.VaultName)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	existing, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_site_recovery_replication_recovery_plan", id.ID())
+	}
+
+	groups, err := expandSiteRecoveryReplicationRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := siterecovery.CreateRecoveryPlanInput{
+		Properties: &siterecovery.CreateRecoveryPlanInputProperties{
+			PrimaryFabricID:       utils.String(d.Get("source_recovery_fabric_id").(string)),
+			RecoveryFabricID:      utils.String(d.Get("target_recovery_fabric_id").(string)),
+			Groups:                groups,
+			ProviderSpecificInput: expandSiteRecoveryReplicationRecoveryPlanA2ASettings(d.Get("azure_to_azure_settings").([]interface{})),
+		},
+	}
+
+	if v := d.Get("failover_deployment_model").(string); v != "" {
+		parameters.Properties.FailoverDeploymentModel = siterecovery.FailoverDeploymentModel(v)
+	}
+
+	future, err := client.Create(ctx, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSiteRecoveryReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceSiteRecoveryReplicationRecoveryPlanUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.ReplicationRecoveryPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(id.ResourceGroup, id.VaultName)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groups, err := expandSiteRecoveryReplicationRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := siterecovery.UpdateRecoveryPlanInput{
+		Properties: &siterecovery.UpdateRecoveryPlanInputProperties{
+			Groups: groups,
+		},
+	}
+
+	future, err := client.Update(ctx, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceSiteRecoveryReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceSiteRecoveryReplicationRecoveryPlanRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.ReplicationRecoveryPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(id.ResourceGroup, id.VaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("source_recovery_fabric_id", handleAzureSdkForGoBug2824(utils.NormalizeNilableString(props.PrimaryFabricID)))
+		d.Set("target_recovery_fabric_id", handleAzureSdkForGoBug2824(utils.NormalizeNilableString(props.RecoveryFabricID)))
+		d.Set("failover_deployment_model", props.FailoverDeploymentModel)
+
+		shutdownGroup, failoverGroup, bootGroups := flattenSiteRecoveryReplicationRecoveryPlanGroups(props.Groups)
+		if err := d.Set("shutdown_recovery_group", shutdownGroup); err != nil {
+			return fmt.Errorf("setting `shutdown_recovery_group`: %+v", err)
+		}
+		if err := d.Set("failover_recovery_group", failoverGroup); err != nil {
+			return fmt.Errorf("setting `failover_recovery_group`: %+v", err)
+		}
+		if err := d.Set("boot_recovery_group", bootGroups); err != nil {
+			return fmt.Errorf("setting `boot_recovery_group`: %+v", err)
+		}
+
+		if err := d.Set("azure_to_azure_settings", flattenSiteRecoveryReplicationRecoveryPlanA2ASettings(props.ProviderSpecificDetails)); err != nil {
+			return fmt.Errorf("setting `azure_to_azure_settings`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSiteRecoveryReplicationRecoveryPlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.ReplicationRecoveryPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(id.ResourceGroup, id.VaultName)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	future, err := client.Delete(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// the API requires the Shutdown and Failover groups to always be present, followed by at least one Boot group
+func expandSiteRecoveryReplicationRecoveryPlanGroups(d *pluginsdk.ResourceData) (*[]siterecovery.RecoveryPlanGroup, error) {
+	shutdownGroup, err := expandSiteRecoveryReplicationRecoveryPlanGroup(siterecovery.Shutdown, d.Get("shutdown_recovery_group").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `shutdown_recovery_group`: %+v", err)
+	}
+
+	failoverGroup, err := expandSiteRecoveryReplicationRecoveryPlanGroup(siterecovery.Failover, d.Get("failover_recovery_group").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `failover_recovery_group`: %+v", err)
+	}
+
+	groups := []siterecovery.RecoveryPlanGroup{*shutdownGroup, *failoverGroup}
+
+	for i, raw := range d.Get("boot_recovery_group").([]interface{}) {
+		bootGroup, err := expandSiteRecoveryReplicationRecoveryPlanGroup(siterecovery.Boot, []interface{}{raw})
+		if err != nil {
+			return nil, fmt.Errorf("expanding `boot_recovery_group.%d`: %+v", i, err)
+		}
+
+		items := make([]siterecovery.RecoveryPlanProtectedItem, 0)
+		if raw != nil {
+			for _, item := range raw.(map[string]interface{})["replicated_protected_items"].([]interface{}) {
+				items = append(items, siterecovery.RecoveryPlanProtectedItem{
+					ID: utils.String(item.(string)),
+				})
+			}
+		}
+		bootGroup.ReplicationProtectedItems = &items
+
+		groups = append(groups, *bootGroup)
+	}
+
+	return &groups, nil
+}
+
+func expandSiteRecoveryReplicationRecoveryPlanGroup(groupType siterecovery.RecoveryPlanGroupType, input []interface{}) (*siterecovery.RecoveryPlanGroup, error) {
+	group := siterecovery.RecoveryPlanGroup{
+		GroupType:         groupType,
+		StartGroupActions: &[]siterecovery.RecoveryPlanAction{},
+		EndGroupActions:   &[]siterecovery.RecoveryPlanAction{},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &group, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	preActions, err := expandSiteRecoveryReplicationRecoveryPlanActions(v["pre_action"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	group.StartGroupActions = preActions
+
+	postActions, err := expandSiteRecoveryReplicationRecoveryPlanActions(v["post_action"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	group.EndGroupActions = postActions
+
+	return &group, nil
+}
+
+func expandSiteRecoveryReplicationRecoveryPlanActions(input []interface{}) (*[]siterecovery.RecoveryPlanAction, error) {
+	actions := make([]siterecovery.RecoveryPlanAction, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		fabricLocation := siterecovery.RecoveryPlanActionLocation(v["fabric_location"].(string))
+		runbookId := v["runbook_id"].(string)
+		instruction := v["manual_action_instruction"].(string)
+		scriptPath := v["script_path"].(string)
+
+		action := siterecovery.RecoveryPlanAction{
+			ActionName: utils.String(name),
+		}
+
+		switch v["type"].(string) {
+		case string(siterecovery.InstanceTypeAutomationRunbookActionDetails):
+			if runbookId == "" || fabricLocation == "" {
+				return nil, fmt.Errorf("`runbook_id` and `fabric_location` must be specified for the action %q when `type` is %q", name, siterecovery.InstanceTypeAutomationRunbookActionDetails)
+			}
+			if instruction != "" || scriptPath != "" {
+				return nil, fmt.Errorf("`manual_action_instruction` and `script_path` cannot be specified for the action %q when `type` is %q", name, siterecovery.InstanceTypeAutomationRunbookActionDetails)
+			}
+
+			action.CustomDetails = siterecovery.RecoveryPlanAutomationRunbookActionDetails{
+				RunbookID:      utils.String(runbookId),
+				FabricLocation: fabricLocation,
+			}
+		case string(siterecovery.InstanceTypeManualActionDetails):
+			if runbookId != "" || scriptPath != "" || fabricLocation != "" {
+				return nil, fmt.Errorf("`runbook_id`, `script_path` and `fabric_location` cannot be specified for the action %q when `type` is %q", name, siterecovery.InstanceTypeManualActionDetails)
+			}
+
+			details := siterecovery.RecoveryPlanManualActionDetails{}
+			if instruction != "" {
+				details.Description = utils.String(instruction)
+			}
+			action.CustomDetails = details
+		case string(siterecovery.InstanceTypeScriptActionDetails):
+			if scriptPath == "" || fabricLocation == "" {
+				return nil, fmt.Errorf("`script_path` and `fabric_location` must be specified for the action %q when `type` is %q", name, siterecovery.InstanceTypeScriptActionDetails)
+			}
+			if runbookId != "" || instruction != "" {
+				return nil, fmt.Errorf("`runbook_id` and `manual_action_instruction` cannot be specified for the action %q when `type` is %q", name, siterecovery.InstanceTypeScriptActionDetails)
+			}
+
+			action.CustomDetails = siterecovery.RecoveryPlanScriptActionDetails{
+				Path:           utils.String(scriptPath),
+				FabricLocation: fabricLocation,
+			}
+		}
+
+		directions := make([]siterecovery.PossibleOperationsDirections, 0)
+		for _, direction := range v["fail_over_directions"].(*pluginsdk.Set).List() {
+			directions = append(directions, siterecovery.PossibleOperationsDirections(direction.(string)))
+		}
+		action.FailoverDirections = &directions
+
+		types := make([]siterecovery.ReplicationProtectedItemOperation, 0)
+		for _, failoverType := range v["fail_over_types"].(*pluginsdk.Set).List() {
+			types = append(types, siterecovery.ReplicationProtectedItemOperation(failoverType.(string)))
+		}
+		action.FailoverTypes = &types
+
+		actions = append(actions, action)
+	}
+
+	return &actions, nil
+}
+
+func expandSiteRecoveryReplicationRecoveryPlanA2ASettings(input []interface{}) *[]siterecovery.BasicRecoveryPlanProviderSpecificInput {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	settings := siterecovery.RecoveryPlanA2AInput{
+		InstanceType: siterecovery.InstanceTypeBasicRecoveryPlanProviderSpecificInputInstanceTypeA2A,
+	}
+
+	if zone := v["primary_zone"].(string); zone != "" {
+		settings.PrimaryZone = utils.String(zone)
+	}
+
+	if zone := v["recovery_zone"].(string); zone != "" {
+		settings.RecoveryZone = utils.String(zone)
+	}
+
+	return &[]siterecovery.BasicRecoveryPlanProviderSpecificInput{settings}
+}
+
+func flattenSiteRecoveryReplicationRecoveryPlanGroups(input *[]siterecovery.RecoveryPlanGroup) (shutdownGroup, failoverGroup, bootGroups []interface{}) {
+	shutdownGroup = make([]interface{}, 0)
+	failoverGroup = make([]interface{}, 0)
+	bootGroups = make([]interface{}, 0)
+
+	if input == nil {
+		return
+	}
+
+	for _, group := range *input {
+		preActions := flattenSiteRecoveryReplicationRecoveryPlanActions(group.StartGroupActions)
+		postActions := flattenSiteRecoveryReplicationRecoveryPlanActions(group.EndGroupActions)
+
+		switch group.GroupType {
+		case siterecovery.Shutdown:
+			// the Shutdown and Failover groups always exist, so are only surfaced when they contain actions
+			if len(preActions) > 0 || len(postActions) > 0 {
+				shutdownGroup = []interface{}{
+					map[string]interface{}{
+						"pre_action":  preActions,
+						"post_action": postActions,
+					},
+				}
+			}
+		case siterecovery.Failover:
+			if len(preActions) > 0 || len(postActions) > 0 {
+				failoverGroup = []interface{}{
+					map[string]interface{}{
+						"pre_action":  preActions,
+						"post_action": postActions,
+					},
+				}
+			}
+		case siterecovery.Boot:
+			items := make([]interface{}, 0)
+			if group.ReplicationProtectedItems != nil {
+				for _, item := range *group.ReplicationProtectedItems {
+					if item.ID != nil {
+						items = append(items, handleAzureSdkForGoBug2824(*item.ID))
+					}
+				}
+			}
+
+			bootGroups = append(bootGroups, map[string]interface{}{
+				"replicated_protected_items": items,
+				"pre_action":                 preActions,
+				"post_action":                postActions,
+			})
+		}
+	}
+
+	return
+}
+
+func flattenSiteRecoveryReplicationRecoveryPlanActions(input *[]siterecovery.RecoveryPlanAction) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, action := range *input {
+		directions := make([]interface{}, 0)
+		if action.FailoverDirections != nil {
+			for _, direction := range *action.FailoverDirections {
+				directions = append(directions, string(direction))
+			}
+		}
+
+		types := make([]interface{}, 0)
+		if action.FailoverTypes != nil {
+			for _, failoverType := range *action.FailoverTypes {
+				types = append(types, string(failoverType))
+			}
+		}
+
+		actionType := ""
+		fabricLocation := ""
+		runbookId := ""
+		instruction := ""
+		scriptPath := ""
+		if action.CustomDetails != nil {
+			if details, ok := action.CustomDetails.AsRecoveryPlanAutomationRunbookActionDetails(); ok && details != nil {
+				actionType = string(siterecovery.InstanceTypeAutomationRunbookActionDetails)
+				fabricLocation = string(details.FabricLocation)
+				runbookId = utils.NormalizeNilableString(details.RunbookID)
+			}
+
+			if details, ok := action.CustomDetails.AsRecoveryPlanManualActionDetails(); ok && details != nil {
+				actionType = string(siterecovery.InstanceTypeManualActionDetails)
+				instruction = utils.NormalizeNilableString(details.Description)
+			}
+
+			if details, ok := action.CustomDetails.AsRecoveryPlanScriptActionDetails(); ok && details != nil {
+				actionType = string(siterecovery.InstanceTypeScriptActionDetails)
+				fabricLocation = string(details.FabricLocation)
+				scriptPath = utils.NormalizeNilableString(details.Path)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                      utils.NormalizeNilableString(action.ActionName),
+			"type":                      actionType,
+			"fail_over_directions":      directions,
+			"fail_over_types":           types,
+			"fabric_location":           fabricLocation,
+			"runbook_id":                runbookId,
+			"manual_action_instruction": instruction,
+			"script_path":               scriptPath,
+		})
+	}
+
+	return results
+}
+
+func flattenSiteRecoveryReplicationRecoveryPlanA2ASettings(input *[]siterecovery.BasicRecoveryPlanProviderSpecificDetails) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	for _, details := range *input {
+		if a2a, ok := details.AsRecoveryPlanA2ADetails(); ok && a2a != nil {
+			// the zones are only returned when zone to zone replication is in use
+			if a2a.PrimaryZone == nil && a2a.RecoveryZone == nil {
+				continue
+			}
+
+			return []interface{}{
+				map[string]interface{}{
+					"primary_zone":  utils.NormalizeNilableString(a2a.PrimaryZone),
+					"recovery_zone": utils.NormalizeNilableString(a2a.RecoveryZone),
+				},
+			}
+		}
+	}
+
+	return []interface{}{}
+}
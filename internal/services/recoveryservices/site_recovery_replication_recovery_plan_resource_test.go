@@ -0,0 +1,138 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SiteRecoveryReplicationRecoveryPlanResource struct{}
+
+func TestAccSiteRecoveryReplicationRecoveryPlan_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+	r := SiteRecoveryReplicationRecoveryPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSiteRecoveryReplicationRecoveryPlan_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+	r := SiteRecoveryReplicationRecoveryPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withActions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t SiteRecoveryReplicationRecoveryPlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ReplicationRecoveryPlanID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ReplicationRecoveryPlansClient(id.ResourceGroup, id.VaultName).Get(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (SiteRecoveryReplicationRecoveryPlanResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  name                      = "acctest-plan-%d"
+  resource_group_name       = azurerm_resource_group.test2.name
+  recovery_vault_name       = azurerm_recovery_services_vault.test.name
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.test1.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.test2.id
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+  }
+}
+`, SiteRecoveryReplicatedVmResource{}.basic(data), data.RandomInteger)
+}
+
+func (SiteRecoveryReplicationRecoveryPlanResource) withActions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  name                      = "acctest-plan-%d"
+  resource_group_name       = azurerm_resource_group.test2.name
+  recovery_vault_name       = azurerm_recovery_services_vault.test.name
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.test1.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.test2.id
+
+  shutdown_recovery_group {
+    pre_action {
+      name                      = "testPreAction"
+      type                      = "ManualActionDetails"
+      fail_over_directions      = ["PrimaryToRecovery"]
+      fail_over_types           = ["TestFailover"]
+      manual_action_instruction = "test instruction"
+    }
+  }
+
+  failover_recovery_group {
+    post_action {
+      name                      = "testPostAction"
+      type                      = "ManualActionDetails"
+      fail_over_directions      = ["PrimaryToRecovery", "RecoveryToPrimary"]
+      fail_over_types           = ["PlannedFailover", "UnplannedFailover"]
+      manual_action_instruction = "test instruction"
+    }
+  }
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+
+    pre_action {
+      name                      = "testBootPreAction"
+      type                      = "ManualActionDetails"
+      fail_over_directions      = ["PrimaryToRecovery"]
+      fail_over_types           = ["TestFailover"]
+      manual_action_instruction = "test instruction"
+    }
+  }
+}
+`, SiteRecoveryReplicatedVmResource{}.basic(data), data.RandomInteger)
+}
@@ -1,6 +1,7 @@
 package redisenterprise
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -36,6 +37,8 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(redisEnterpriseDatabaseModulesDiff),
+
 		// Since update is not currently supported all attribute have to be marked as FORCE NEW
 		// until support for Update comes online in the near future
 		Schema: map[string]*pluginsdk.Schema{
@@ -110,6 +113,7 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 								"RedisBloom",
 								"RedisTimeSeries",
 								"RediSearch",
+								"RedisJSON",
 							}, false),
 						},
 
@@ -319,6 +323,31 @@ func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta inter
 	return nil
 }
 
+func redisEnterpriseDatabaseModulesDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	modules := make(map[string]bool)
+	for _, item := range d.Get("module").([]interface{}) {
+		if item == nil {
+			continue
+		}
+		name := item.(map[string]interface{})["name"].(string)
+		if modules[name] {
+			return fmt.Errorf("the module %q can only be specified once", name)
+		}
+		modules[name] = true
+	}
+
+	if modules["RediSearch"] {
+		if clusteringPolicy := d.Get("clustering_policy").(string); clusteringPolicy != string(redisenterprise.EnterpriseCluster) {
+			return fmt.Errorf("the `RediSearch` module requires `clustering_policy` to be %q, got %q", string(redisenterprise.EnterpriseCluster), clusteringPolicy)
+		}
+		if evictionPolicy := d.Get("eviction_policy").(string); evictionPolicy != string(redisenterprise.NoEviction) {
+			return fmt.Errorf("the `RediSearch` module requires `eviction_policy` to be %q, got %q", string(redisenterprise.NoEviction), evictionPolicy)
+		}
+	}
+
+	return nil
+}
+
 func expandArmDatabaseModuleArray(input []interface{}) *[]redisenterprise.Module {
 	results := make([]redisenterprise.Module, 0)
 
@@ -57,6 +57,20 @@ func TestRedisEnterpriseDatabase_complete(t *testing.T) {
 	})
 }
 
+func TestRedisEnterpriseDatabase_redisJSON(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.redisJSON(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r RedisenterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RedisEnterpriseDatabaseID(state.ID)
 	if err != nil {
@@ -154,3 +168,26 @@ resource "azurerm_redis_enterprise_database" "test" {
 }
 `, template)
 }
+
+func (r RedisenterpriseDatabaseResource) redisJSON(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  module {
+    name = "RediSearch"
+  }
+
+  module {
+    name = "RedisJSON"
+  }
+}
+`, template)
+}
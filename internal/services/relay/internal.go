@@ -58,6 +58,50 @@ func authorizationRuleSchemaFrom(s map[string]*pluginsdk.Schema) map[string]*plu
 	return azure.MergeSchema(s, authSchema)
 }
 
+func authorizationRuleDataSourceSchemaFrom(s map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	authSchema := map[string]*pluginsdk.Schema{
+		"listen": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"send": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"manage": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"primary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+	return azure.MergeSchema(s, authSchema)
+}
+
 func expandAuthorizationRuleRights(d *pluginsdk.ResourceData) []namespaces.AccessRights {
 	rights := make([]namespaces.AccessRights, 0)
 
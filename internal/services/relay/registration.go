@@ -20,7 +20,11 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurerm_relay_hybrid_connection":                    dataSourceRelayHybridConnection(),
+		"azurerm_relay_hybrid_connection_authorization_rule": dataSourceRelayHybridConnectionAuthorizationRule(),
+		"azurerm_relay_namespace_authorization_rule":         dataSourceRelayNamespaceAuthorizationRule(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
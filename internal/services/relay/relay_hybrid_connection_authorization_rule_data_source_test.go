@@ -0,0 +1,44 @@
+package relay_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RelayHybridConnectionAuthorizationRuleDataSource struct{}
+
+func TestAccRelayHybridConnectionAuthorizationRuleDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_relay_hybrid_connection_authorization_rule", "test")
+	r := RelayHybridConnectionAuthorizationRuleDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("listen").HasValue("true"),
+				check.That(data.ResourceName).Key("send").HasValue("true"),
+				check.That(data.ResourceName).Key("manage").HasValue("false"),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+			),
+		},
+	})
+}
+
+func (RelayHybridConnectionAuthorizationRuleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_relay_hybrid_connection_authorization_rule" "test" {
+  name                   = azurerm_relay_hybrid_connection_authorization_rule.test.name
+  namespace_name         = azurerm_relay_namespace.test.name
+  hybrid_connection_name = azurerm_relay_hybrid_connection.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+}
+`, RelayHybridConnectionAuthorizationRuleResource{}.basic(data))
+}
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -39,6 +40,8 @@ func resourceSearchService() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(searchServiceCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -309,6 +312,56 @@ func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+func searchServiceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the counts read as 0 when they're interpolated and not yet known, so they can only be validated once known
+	if !d.NewValueKnown("replica_count") || !d.NewValueKnown("partition_count") {
+		return nil
+	}
+
+	skuName := search.SkuName(d.Get("sku").(string))
+	replicaCount := d.Get("replica_count").(int)
+	partitionCount := d.Get("partition_count").(int)
+
+	switch skuName {
+	case search.Free:
+		if replicaCount > 1 || partitionCount > 1 {
+			return fmt.Errorf("`replica_count` and `partition_count` cannot be greater than 1 when `sku` is %q", string(skuName))
+		}
+
+	case search.Basic:
+		if replicaCount > 3 {
+			return fmt.Errorf("`replica_count` must be between 1 and 3 when `sku` is %q, got %d", string(skuName), replicaCount)
+		}
+		if partitionCount > 1 {
+			return fmt.Errorf("`partition_count` cannot be greater than 1 when `sku` is %q", string(skuName))
+		}
+
+	default:
+		if replicaCount > 12 {
+			return fmt.Errorf("`replica_count` must be between 1 and 12 when `sku` is %q, got %d", string(skuName), replicaCount)
+		}
+
+		if partitionCount != 0 {
+			valid := false
+			for _, v := range []int{1, 2, 3, 4, 6, 12} {
+				if partitionCount == v {
+					valid = true
+				}
+			}
+			if !valid {
+				return fmt.Errorf("`partition_count` must be one of 1, 2, 3, 4, 6 or 12 when `sku` is %q, got %d", string(skuName), partitionCount)
+			}
+		}
+
+		// a Search Service can use at most 36 Search Units (replicas multiplied by partitions)
+		if replicaCount != 0 && partitionCount != 0 && replicaCount*partitionCount > 36 {
+			return fmt.Errorf("the product of `replica_count` and `partition_count` cannot exceed 36 Search Units, got %d", replicaCount*partitionCount)
+		}
+	}
+
+	return nil
+}
+
 func flattenSearchQueryKeys(input []search.QueryKey) []interface{} {
 	results := make([]interface{}, 0)
 
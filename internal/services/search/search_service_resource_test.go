@@ -3,6 +3,7 @@ package search_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -158,6 +159,22 @@ func TestAccSearchService_identity(t *testing.T) {
 	})
 }
 
+func TestAccSearchService_invalidPartitionCount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
+	r := SearchServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.partitionCount(data, "basic", 2),
+			ExpectError: regexp.MustCompile("`partition_count` cannot be greater than 1"),
+		},
+		{
+			Config:      r.partitionCount(data, "standard", 5),
+			ExpectError: regexp.MustCompile("`partition_count` must be one of 1, 2, 3, 4, 6 or 12"),
+		},
+	})
+}
+
 func (t SearchServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SearchServiceID(state.ID)
 	if err != nil {
@@ -295,3 +312,24 @@ resource "azurerm_search_service" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (SearchServiceResource) partitionCount(data acceptance.TestData, sku string, partitionCount int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "%s"
+  partition_count     = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sku, partitionCount)
+}
@@ -61,6 +61,16 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceIDOrEmpty,
 			},
 
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pending_replication_operations_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"primary_connection_string_alias": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -154,9 +164,15 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *pluginsdk.Resour
 		}
 	}
 
+	// removing the partner namespace only breaks the pairing, leaving the alias in place on the primary namespace
+	partnerNamespaceId := d.Get("partner_namespace_id").(string)
+	if partnerNamespaceId == "" {
+		return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+	}
+
 	parameters := servicebus.ArmDisasterRecovery{
 		ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
-			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+			PartnerNamespace: utils.String(partnerNamespaceId),
 		},
 	}
 
@@ -196,6 +212,13 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 
 	if props := resp.ArmDisasterRecoveryProperties; props != nil {
 		d.Set("partner_namespace_id", props.PartnerNamespace)
+		d.Set("role", string(props.Role))
+
+		pendingReplicationOperationsCount := 0
+		if props.PendingReplicationOperationsCount != nil {
+			pendingReplicationOperationsCount = int(*props.PendingReplicationOperationsCount)
+		}
+		d.Set("pending_replication_operations_count", pendingReplicationOperationsCount)
 	}
 
 	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName, serviceBusNamespaceDefaultAuthorizationRule)
@@ -222,17 +245,20 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 		return err
 	}
 
-	breakPair, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
-	if err != nil {
-		return fmt.Errorf("breaking pairing %s: %+v", id, err)
-	}
+	// the pairing will already have been broken if the partner namespace was removed
+	if d.Get("partner_namespace_id").(string) != "" {
+		breakPair, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+		if err != nil {
+			return fmt.Errorf("breaking pairing %s: %+v", id, err)
+		}
 
-	if breakPair.StatusCode != http.StatusOK {
-		return fmt.Errorf("breaking pairing for %s: %+v", *id, err)
-	}
+		if breakPair.StatusCode != http.StatusOK {
+			return fmt.Errorf("breaking pairing for %s: %+v", *id, err)
+		}
 
-	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
-		return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+			return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		}
 	}
 
 	if _, err := client.Delete(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
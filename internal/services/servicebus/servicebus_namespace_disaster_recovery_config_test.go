@@ -30,6 +30,29 @@ func TestAccAzureRMServiceBusNamespacePairing_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespacePairing_breakPairing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.breakPairing(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NamespaceDisasterRecoveryConfigID(state.ID)
 	if err != nil {
@@ -44,7 +67,31 @@ func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Co
 	return utils.Bool(resp.ID != nil), nil
 }
 
-func (ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) breakPairing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = ""
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -75,11 +122,5 @@ resource "azurerm_servicebus_namespace" "secondary_namespace_test" {
   sku                 = "Premium"
   capacity            = "1"
 }
-
-resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
-  name                 = "acctest-alias-%[1]d"
-  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
-  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
-}
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
@@ -1,8 +1,10 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
@@ -80,8 +82,9 @@ func resourceServiceBusSubscriptionRule() *pluginsdk.Resource {
 			},
 
 			"action": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.SqlRuleAction,
 			},
 
 			"sql_filter": {
@@ -176,7 +179,38 @@ func resourceServiceBusSubscriptionRule() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(subscriptionRuleCustomizeDiff),
+	}
+}
+
+func subscriptionRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the values of `sql_filter` and `correlation_filter` may not be known until apply time
+	if !d.NewValueKnown("sql_filter") || !d.NewValueKnown("correlation_filter") {
+		return nil
+	}
+
+	_, hasSqlFilter := d.GetOk("sql_filter")
+	_, hasCorrelationFilter := d.GetOk("correlation_filter")
+
+	switch filterType := d.Get("filter_type").(string); {
+	case strings.EqualFold(filterType, string(servicebus.FilterTypeSQLFilter)):
+		if !hasSqlFilter {
+			return fmt.Errorf("`sql_filter` is required when `filter_type` is set to `SqlFilter`")
+		}
+		if hasCorrelationFilter {
+			return fmt.Errorf("`correlation_filter` cannot be specified when `filter_type` is set to `SqlFilter`")
+		}
+	case strings.EqualFold(filterType, string(servicebus.FilterTypeCorrelationFilter)):
+		if !hasCorrelationFilter {
+			return fmt.Errorf("`correlation_filter` is required when `filter_type` is set to `CorrelationFilter`")
+		}
+		if hasSqlFilter {
+			return fmt.Errorf("`sql_filter` cannot be specified when `filter_type` is set to `CorrelationFilter`")
+		}
 	}
+
+	return nil
 }
 
 func resourceServiceBusSubscriptionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
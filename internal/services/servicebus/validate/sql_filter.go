@@ -2,6 +2,8 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 )
 
 func SqlFilter(i interface{}, k string) (warnings []string, errors []error) {
@@ -23,5 +25,89 @@ func SqlFilter(i interface{}, k string) (warnings []string, errors []error) {
 		return
 	}
 
+	if err := sqlExpressionIsBalanced(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid SQL expression: %+v", k, err))
+		return
+	}
+
+	// a filter ending with a logical operator is missing its right hand side
+	if regexp.MustCompile(`(?i)(^|[\s)])(AND|OR|NOT)$`).MatchString(strings.TrimSpace(v)) {
+		errors = append(errors, fmt.Errorf("%q is not a valid SQL expression: the expression ends with a logical operator", k))
+		return
+	}
+
+	return warnings, errors
+}
+
+func SqlRuleAction(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string: %q", k, v))
+		return
+	}
+
+	// SqlRuleActions have a maximum length of 1024
+	if len(v) > 1024 {
+		errors = append(errors, fmt.Errorf("%q is of length %d, which exceeds the maximum length of 1024", k, len(v)))
+		return
+	}
+
+	if err := sqlExpressionIsBalanced(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid SQL action: %+v", k, err))
+		return
+	}
+
+	// every statement within an action must either SET or REMOVE a property
+	if !regexp.MustCompile(`(?i)^(SET|REMOVE)\s`).MatchString(strings.TrimSpace(v)) {
+		errors = append(errors, fmt.Errorf("%q is not a valid SQL action: an action must start with `SET` or `REMOVE`", k))
+		return
+	}
+
 	return warnings, errors
 }
+
+// sqlExpressionIsBalanced checks that all string literals are terminated and that all parentheses
+// outside of string literals are balanced. Single quotes within a string literal are escaped by doubling them.
+func sqlExpressionIsBalanced(input string) error {
+	depth := 0
+	inString := false
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if inString {
+			if c == '\'' {
+				if i+1 < len(input) && input[i+1] == '\'' {
+					i++
+					continue
+				}
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '\'':
+			inString = true
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected `)` at position %d", i)
+			}
+		}
+	}
+
+	if inString {
+		return fmt.Errorf("unterminated string literal")
+	}
+	if depth != 0 {
+		return fmt.Errorf("unbalanced parentheses")
+	}
+
+	return nil
+}
@@ -22,6 +22,34 @@ func TestValidateSqlFilter(t *testing.T) {
 			Value:       strings.Repeat("user.foo='bar' AND ", 55)[:1040],
 			ShouldError: true,
 		},
+		{
+			Value:       "(colour = 'red' OR colour = 'blue') AND quantity > 10",
+			ShouldError: false,
+		},
+		{
+			Value:       "name = 'O''Brien (Jr'",
+			ShouldError: false,
+		},
+		{
+			Value:       "operand = 'unterminated",
+			ShouldError: true,
+		},
+		{
+			Value:       "(colour = 'red' OR colour = 'blue'",
+			ShouldError: true,
+		},
+		{
+			Value:       "colour = 'red')",
+			ShouldError: true,
+		},
+		{
+			Value:       "colour = 'red' AND",
+			ShouldError: true,
+		},
+		{
+			Value:       "brand = 'android'",
+			ShouldError: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -37,3 +65,48 @@ func TestValidateSqlFilter(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSqlRuleAction(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{
+			Value:       "SET sys.Label = 'terraform'",
+			ShouldError: false,
+		},
+		{
+			Value:       "set quantity = quantity + 1; REMOVE colour",
+			ShouldError: false,
+		},
+		{
+			Value:       "",
+			ShouldError: true,
+		},
+		{
+			Value:       "UPDATE quantity = 1",
+			ShouldError: true,
+		},
+		{
+			Value:       "SET sys.Label = 'terraform",
+			ShouldError: true,
+		},
+		{
+			Value:       "SET quantity = (quantity + 1",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := SqlRuleAction(tc.Value, "action")
+
+		hasErrors := len(errors) > 0
+		if hasErrors && !tc.ShouldError {
+			t.Fatalf("Expected no errors but got %q for %q", errors[0], tc.Value)
+		}
+
+		if !hasErrors && tc.ShouldError {
+			t.Fatalf("Expected errors but got %d for %q", len(errors), tc.Value)
+		}
+	}
+}
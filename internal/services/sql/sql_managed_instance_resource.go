@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -64,6 +65,10 @@ func resourceArmSqlMiServer() *schema.Resource {
 					"GP_Gen5",
 					"BC_Gen4",
 					"BC_Gen5",
+					"GP_G8IM",
+					"GP_G8IH",
+					"BC_G8IM",
+					"BC_G8IH",
 				}, false),
 			},
 
@@ -91,15 +96,19 @@ func resourceArmSqlMiServer() *schema.Resource {
 					24,
 					32,
 					40,
+					48,
+					56,
 					64,
 					80,
+					96,
+					128,
 				}),
 			},
 
 			"storage_size_in_gb": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validation.IntBetween(32, 8192),
+				ValidateFunc: validation.IntBetween(32, 16384),
 			},
 
 			"license_type": {
@@ -173,6 +182,13 @@ func resourceArmSqlMiServer() *schema.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"maintenance_configuration_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ManagedInstanceMaintenanceConfigurationName,
+			},
+
 			"identity": managedInstanceIdentity{}.Schema(),
 
 			"storage_account_type": {
@@ -195,6 +211,7 @@ func resourceArmSqlMiServer() *schema.Resource {
 			pluginsdk.ForceNewIfChange("dns_zone_partner_id", func(ctx context.Context, old, new, _ interface{}) bool {
 				return old.(string) == "" && new.(string) != ""
 			}),
+			validateManagedInstanceSkuCapacity,
 		),
 	}
 }
@@ -248,6 +265,10 @@ func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}
 		},
 	}
 
+	if v, ok := d.GetOk("maintenance_configuration_name"); ok {
+		parameters.ManagedInstanceProperties.MaintenanceConfigurationID = utils.String(maintenanceParse.NewPublicMaintenanceConfigurationID(subscriptionId, v.(string)).ID())
+	}
+
 	identity, err := expandManagedInstanceIdentity(d.Get("identity").([]interface{}), d.IsNewResource())
 	if err != nil {
 		return fmt.Errorf(`expanding "identity": %v`, err)
@@ -321,6 +342,16 @@ func resourceArmSqlMiServerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("proxy_override", props.ProxyOverride)
 		d.Set("timezone_id", props.TimezoneID)
 		d.Set("storage_account_type", props.StorageAccountType)
+
+		maintenanceConfigurationName := ""
+		if props.MaintenanceConfigurationID != nil {
+			maintenanceConfigId, err := maintenanceParse.PublicMaintenanceConfigurationID(*props.MaintenanceConfigurationID)
+			if err != nil {
+				return err
+			}
+			maintenanceConfigurationName = maintenanceConfigId.Name
+		}
+		d.Set("maintenance_configuration_name", maintenanceConfigurationName)
 		// This value is not returned from the api so we'll just set whatever is in the config
 		d.Set("administrator_login_password", d.Get("administrator_login_password").(string))
 	}
@@ -346,6 +377,46 @@ func resourceArmSqlMiServerDelete(d *schema.ResourceData, meta interface{}) erro
 	return future.WaitForCompletionRef(ctx, client.Client)
 }
 
+// validateManagedInstanceSkuCapacity validates the `vcores` and `storage_size_in_gb` against the hardware generation in `sku_name`
+func validateManagedInstanceSkuCapacity(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	skuName := d.Get("sku_name").(string)
+	vCores := d.Get("vcores").(int)
+	storageSize := d.Get("storage_size_in_gb").(int)
+
+	parts := strings.Split(skuName, "_")
+	if len(parts) != 2 {
+		return nil
+	}
+
+	var maxVCores, maxStorageSize int
+	switch parts[1] {
+	case "Gen4":
+		maxVCores, maxStorageSize = 24, 8192
+	case "Gen5":
+		maxVCores, maxStorageSize = 80, 8192
+	case "G8IM":
+		// premium-series
+		maxVCores, maxStorageSize = 128, 16384
+	case "G8IH":
+		// memory optimized premium-series
+		maxVCores, maxStorageSize = 64, 16384
+	default:
+		return nil
+	}
+
+	if vCores > maxVCores {
+		return fmt.Errorf("`vcores` must be at most %d when `sku_name` is %q, got %d", maxVCores, skuName, vCores)
+	}
+	if parts[1] == "Gen5" && (vCores == 48 || vCores == 56) {
+		return fmt.Errorf("`vcores` of %d is only supported by the premium-series hardware generations (`G8IM` and `G8IH`)", vCores)
+	}
+	if storageSize > maxStorageSize {
+		return fmt.Errorf("`storage_size_in_gb` must be at most %d when `sku_name` is %q, got %d", maxStorageSize, skuName, storageSize)
+	}
+
+	return nil
+}
+
 func expandManagedInstanceSkuName(skuName string) (*sql.Sku, error) {
 	parts := strings.Split(skuName, "_")
 	if len(parts) != 2 {
@@ -0,0 +1,46 @@
+package streamanalytics
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaStreamAnalyticsAuthenticationMode() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(streamanalytics.ConnectionString),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(streamanalytics.ConnectionString),
+			string(streamanalytics.Msi),
+		}, false),
+	}
+}
+
+// validateStreamAnalyticsConnectionStringCredentials ensures that the credential fields are specified when the
+// `authentication_mode` is `ConnectionString` - when using `Msi` the Job's Managed Identity is used instead
+func validateStreamAnalyticsConnectionStringCredentials(d *pluginsdk.ResourceData, fields ...string) error {
+	if d.Get("authentication_mode").(string) != string(streamanalytics.ConnectionString) {
+		return nil
+	}
+
+	for _, field := range fields {
+		if d.Get(field).(string) == "" {
+			return fmt.Errorf("`%s` must be specified when `authentication_mode` is set to %q", field, string(streamanalytics.ConnectionString))
+		}
+	}
+
+	return nil
+}
+
+func flattenStreamAnalyticsAuthenticationMode(input streamanalytics.AuthenticationMode) string {
+	// the API omits the authentication mode for data sources created before it was introduced
+	if input == "" {
+		return string(streamanalytics.ConnectionString)
+	}
+
+	return string(input)
+}
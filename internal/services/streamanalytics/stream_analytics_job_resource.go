@@ -146,6 +146,40 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 				},
 			},
 
+			"content_storage_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(streamanalytics.ContentStoragePolicySystemAccount),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.ContentStoragePolicySystemAccount),
+					string(streamanalytics.ContentStoragePolicyJobStorageAccount),
+				}, false),
+			},
+
+			"job_storage_account": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"account_key": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+					},
+				},
+			},
+
 			"job_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -233,6 +267,18 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		props.Identity = expandStreamAnalyticsJobIdentity(identity.([]interface{}))
 	}
 
+	contentStoragePolicy := d.Get("content_storage_policy").(string)
+	props.StreamingJobProperties.ContentStoragePolicy = streamanalytics.ContentStoragePolicy(contentStoragePolicy)
+
+	jobStorageAccount, err := expandStreamAnalyticsJobStorageAccount(d.Get("job_storage_account").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `job_storage_account`: %+v", err)
+	}
+	if contentStoragePolicy == string(streamanalytics.ContentStoragePolicyJobStorageAccount) && jobStorageAccount == nil {
+		return fmt.Errorf("`job_storage_account` must be specified when `content_storage_policy` is set to %q", contentStoragePolicy)
+	}
+	props.StreamingJobProperties.JobStorageAccount = jobStorageAccount
+
 	if d.IsNewResource() {
 		props.StreamingJobProperties.Transformation = &transformation
 
@@ -313,6 +359,16 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("events_out_of_order_policy", string(props.EventsOutOfOrderPolicy))
 		d.Set("output_error_policy", string(props.OutputErrorPolicy))
 
+		contentStoragePolicy := string(streamanalytics.ContentStoragePolicySystemAccount)
+		if props.ContentStoragePolicy != "" {
+			contentStoragePolicy = string(props.ContentStoragePolicy)
+		}
+		d.Set("content_storage_policy", contentStoragePolicy)
+
+		if err := d.Set("job_storage_account", flattenStreamAnalyticsJobStorageAccount(d, props.JobStorageAccount)); err != nil {
+			return fmt.Errorf("setting `job_storage_account`: %+v", err)
+		}
+
 		// Computed
 		d.Set("job_id", props.JobID)
 
@@ -384,3 +440,51 @@ func flattenStreamAnalyticsJobIdentity(identity *streamanalytics.Identity) []int
 		},
 	}
 }
+
+func expandStreamAnalyticsJobStorageAccount(input []interface{}) (*streamanalytics.JobStorageAccount, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	authenticationMode := v["authentication_mode"].(string)
+	result := &streamanalytics.JobStorageAccount{
+		AuthenticationMode: streamanalytics.AuthenticationMode(authenticationMode),
+		AccountName:        utils.String(v["account_name"].(string)),
+	}
+
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		accountKey := v["account_key"].(string)
+		if accountKey == "" {
+			return nil, fmt.Errorf("`account_key` must be specified when `authentication_mode` is set to %q", authenticationMode)
+		}
+		result.AccountKey = utils.String(accountKey)
+	}
+
+	return result, nil
+}
+
+func flattenStreamAnalyticsJobStorageAccount(d *pluginsdk.ResourceData, input *streamanalytics.JobStorageAccount) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	accountName := ""
+	if input.AccountName != nil {
+		accountName = *input.AccountName
+	}
+
+	// the account key isn't returned by the API, so we look it up from the config
+	accountKey := ""
+	if v, ok := d.GetOk("job_storage_account.0.account_key"); ok {
+		accountKey = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"account_name":        accountName,
+			"account_key":         accountKey,
+			"authentication_mode": flattenStreamAnalyticsAuthenticationMode(input.AuthenticationMode),
+		},
+	}
+}
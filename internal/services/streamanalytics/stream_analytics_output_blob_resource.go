@@ -66,7 +66,7 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"storage_account_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -91,6 +91,8 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"batch_max_wait_time": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -125,12 +127,23 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "storage_account_key"); err != nil {
+		return err
+	}
+
 	containerName := d.Get("storage_container_name").(string)
 	dateFormat := d.Get("date_format").(string)
 	pathPattern := d.Get("path_pattern").(string)
-	storageAccountKey := d.Get("storage_account_key").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 	timeFormat := d.Get("time_format").(string)
+	authenticationMode := streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string))
+
+	storageAccount := streamanalytics.StorageAccount{
+		AccountName: utils.String(storageAccountName),
+	}
+	if authenticationMode == streamanalytics.ConnectionString {
+		storageAccount.AccountKey = utils.String(d.Get("storage_account_key").(string))
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -145,15 +158,13 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 				Type: streamanalytics.TypeMicrosoftStorageBlob,
 				BlobOutputDataSourceProperties: &streamanalytics.BlobOutputDataSourceProperties{
 					StorageAccounts: &[]streamanalytics.StorageAccount{
-						{
-							AccountKey:  utils.String(storageAccountKey),
-							AccountName: utils.String(storageAccountName),
-						},
+						storageAccount,
 					},
-					Container:   utils.String(containerName),
-					DateFormat:  utils.String(dateFormat),
-					PathPattern: utils.String(pathPattern),
-					TimeFormat:  utils.String(timeFormat),
+					Container:          utils.String(containerName),
+					DateFormat:         utils.String(dateFormat),
+					PathPattern:        utils.String(pathPattern),
+					TimeFormat:         utils.String(timeFormat),
+					AuthenticationMode: authenticationMode,
 				},
 			},
 			Serialization: serialization,
@@ -222,6 +233,7 @@ func resourceStreamAnalyticsOutputBlobRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("path_pattern", v.PathPattern)
 		d.Set("storage_container_name", v.Container)
 		d.Set("time_format", v.TimeFormat)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if accounts := v.StorageAccounts; accounts != nil && len(*accounts) > 0 {
 			account := (*accounts)[0]
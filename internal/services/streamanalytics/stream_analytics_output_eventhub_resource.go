@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -92,6 +92,8 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -116,10 +118,12 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "shared_access_policy_key", "shared_access_policy_name"); err != nil {
+		return err
+	}
+
 	eventHubName := d.Get("eventhub_name").(string)
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
-	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
-	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
 	propertyColumns := d.Get("property_columns").([]interface{})
 	partitionKey := d.Get("partition_key").(string)
 
@@ -129,19 +133,25 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 		return fmt.Errorf("expanding `serialization`: %+v", err)
 	}
 
+	dataSourceProperties := &streamanalytics.EventHubOutputDataSourceProperties{
+		EventHubName:        utils.String(eventHubName),
+		ServiceBusNamespace: utils.String(serviceBusNamespace),
+		PropertyColumns:     utils.ExpandStringSlice(propertyColumns),
+		PartitionKey:        utils.String(partitionKey),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
+	}
+
+	if dataSourceProperties.AuthenticationMode == streamanalytics.ConnectionString {
+		dataSourceProperties.SharedAccessPolicyKey = utils.String(d.Get("shared_access_policy_key").(string))
+		dataSourceProperties.SharedAccessPolicyName = utils.String(d.Get("shared_access_policy_name").(string))
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.EventHubOutputDataSource{
-				Type: streamanalytics.TypeMicrosoftServiceBusEventHub,
-				EventHubOutputDataSourceProperties: &streamanalytics.EventHubOutputDataSourceProperties{
-					EventHubName:           utils.String(eventHubName),
-					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
-					PropertyColumns:        utils.ExpandStringSlice(propertyColumns),
-					PartitionKey:           utils.String(partitionKey),
-				},
+				Type:                               streamanalytics.TypeMicrosoftServiceBusEventHub,
+				EventHubOutputDataSourceProperties: dataSourceProperties,
 			},
 			Serialization: serialization,
 		},
@@ -196,6 +206,7 @@ func resourceStreamAnalyticsOutputEventHubRead(d *pluginsdk.ResourceData, meta i
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
 		d.Set("property_columns", v.PropertyColumns)
 		d.Set("partition_key", v.PartitionKey)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -79,17 +79,19 @@ func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
 
 			"user": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"password": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -112,24 +114,32 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "user", "password"); err != nil {
+		return err
+	}
+
 	server := d.Get("server").(string)
 	databaseName := d.Get("database").(string)
 	tableName := d.Get("table").(string)
-	sqlUser := d.Get("user").(string)
-	sqlUserPassword := d.Get("password").(string)
+
+	dataSourceProperties := &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
+		Server:             utils.String(server),
+		Database:           utils.String(databaseName),
+		Table:              utils.String(tableName),
+		AuthenticationMode: streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
+	}
+
+	if dataSourceProperties.AuthenticationMode == streamanalytics.ConnectionString {
+		dataSourceProperties.User = utils.String(d.Get("user").(string))
+		dataSourceProperties.Password = utils.String(d.Get("password").(string))
+	}
 
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.AzureSQLDatabaseOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftSQLServerDatabase,
-				AzureSQLDatabaseOutputDataSourceProperties: &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
-					Server:   utils.String(server),
-					Database: utils.String(databaseName),
-					User:     utils.String(sqlUser),
-					Password: utils.String(sqlUserPassword),
-					Table:    utils.String(tableName),
-				},
+				AzureSQLDatabaseOutputDataSourceProperties: dataSourceProperties,
 			},
 		},
 	}
@@ -182,6 +192,7 @@ func resourceStreamAnalyticsOutputSqlRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("database", v.Database)
 		d.Set("table", v.Table)
 		d.Set("user", v.User)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 	}
 
 	return nil
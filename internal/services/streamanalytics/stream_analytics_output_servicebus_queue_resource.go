@@ -66,18 +66,20 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -102,10 +104,12 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "shared_access_policy_key", "shared_access_policy_name"); err != nil {
+		return err
+	}
+
 	queueName := d.Get("queue_name").(string)
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
-	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
-	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -113,17 +117,23 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 		return fmt.Errorf("expanding `serialization`: %+v", err)
 	}
 
+	dataSourceProperties := &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
+		QueueName:           utils.String(queueName),
+		ServiceBusNamespace: utils.String(serviceBusNamespace),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
+	}
+
+	if dataSourceProperties.AuthenticationMode == streamanalytics.ConnectionString {
+		dataSourceProperties.SharedAccessPolicyKey = utils.String(d.Get("shared_access_policy_key").(string))
+		dataSourceProperties.SharedAccessPolicyName = utils.String(d.Get("shared_access_policy_name").(string))
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.ServiceBusQueueOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusQueue,
-				ServiceBusQueueOutputDataSourceProperties: &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
-					QueueName:              utils.String(queueName),
-					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
-				},
+				ServiceBusQueueOutputDataSourceProperties: dataSourceProperties,
 			},
 			Serialization: serialization,
 		},
@@ -177,6 +187,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueRead(d *pluginsdk.ResourceData,
 		d.Set("queue_name", v.QueueName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -87,6 +87,8 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -113,24 +115,34 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "shared_access_policy_key", "shared_access_policy_name"); err != nil {
+		return err
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
 	if err != nil {
 		return fmt.Errorf("expanding `serialization`: %+v", err)
 	}
 
+	dataSourceProperties := &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
+		TopicName:           utils.String(d.Get("topic_name").(string)),
+		ServiceBusNamespace: utils.String(d.Get("servicebus_namespace").(string)),
+		PropertyColumns:     utils.ExpandStringSlice(d.Get("property_columns").([]interface{})),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
+	}
+
+	if dataSourceProperties.AuthenticationMode == streamanalytics.ConnectionString {
+		dataSourceProperties.SharedAccessPolicyKey = utils.String(d.Get("shared_access_policy_key").(string))
+		dataSourceProperties.SharedAccessPolicyName = utils.String(d.Get("shared_access_policy_name").(string))
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.ServiceBusTopicOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusTopic,
-				ServiceBusTopicOutputDataSourceProperties: &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
-					TopicName:              utils.String(d.Get("topic_name").(string)),
-					ServiceBusNamespace:    utils.String(d.Get("servicebus_namespace").(string)),
-					SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
-					SharedAccessPolicyName: utils.String(d.Get("shared_access_policy_name").(string)),
-					PropertyColumns:        utils.ExpandStringSlice(d.Get("property_columns").([]interface{})),
-				},
+				ServiceBusTopicOutputDataSourceProperties: dataSourceProperties,
 			},
 			Serialization: serialization,
 		},
@@ -184,6 +196,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicRead(d *pluginsdk.ResourceData,
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
 		d.Set("property_columns", v.PropertyColumns)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
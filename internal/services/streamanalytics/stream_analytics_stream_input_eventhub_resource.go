@@ -72,18 +72,20 @@ func resourceStreamAnalyticsStreamInputEventHub() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"serialization": schemaStreamAnalyticsStreamInputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -109,6 +111,10 @@ func resourceStreamAnalyticsStreamInputEventHubCreateUpdate(d *pluginsdk.Resourc
 		}
 	}
 
+	if err := validateStreamAnalyticsConnectionStringCredentials(d, "shared_access_policy_key", "shared_access_policy_name"); err != nil {
+		return err
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsStreamInputSerialization(serializationRaw)
 	if err != nil {
@@ -116,10 +122,14 @@ func resourceStreamAnalyticsStreamInputEventHubCreateUpdate(d *pluginsdk.Resourc
 	}
 
 	eventHubDataSourceProps := &streamanalytics.EventHubStreamInputDataSourceProperties{
-		EventHubName:           utils.String(d.Get("eventhub_name").(string)),
-		ServiceBusNamespace:    utils.String(d.Get("servicebus_namespace").(string)),
-		SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
-		SharedAccessPolicyName: utils.String(d.Get("shared_access_policy_name").(string)),
+		EventHubName:        utils.String(d.Get("eventhub_name").(string)),
+		ServiceBusNamespace: utils.String(d.Get("servicebus_namespace").(string)),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
+	}
+
+	if eventHubDataSourceProps.AuthenticationMode == streamanalytics.ConnectionString {
+		eventHubDataSourceProps.SharedAccessPolicyKey = utils.String(d.Get("shared_access_policy_key").(string))
+		eventHubDataSourceProps.SharedAccessPolicyName = utils.String(d.Get("shared_access_policy_name").(string))
 	}
 
 	if v, ok := d.GetOk("eventhub_consumer_group_name"); ok {
@@ -190,6 +200,7 @@ func resourceStreamAnalyticsStreamInputEventHubRead(d *pluginsdk.ResourceData, m
 		d.Set("eventhub_name", eventHub.EventHubName)
 		d.Set("servicebus_namespace", eventHub.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", eventHub.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(eventHub.AuthenticationMode))
 
 		consumerGroupName := ""
 		if eventHub.ConsumerGroupName != nil {
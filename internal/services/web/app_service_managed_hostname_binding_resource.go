@@ -0,0 +1,341 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAppServiceManagedHostnameBinding() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAppServiceManagedHostnameBindingCreate,
+		Read:   resourceAppServiceManagedHostnameBindingRead,
+		Update: resourceAppServiceManagedHostnameBindingUpdate,
+		Delete: resourceAppServiceManagedHostnameBindingDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HostnameBindingID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"hostname": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"app_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AppServiceID,
+			},
+
+			"ssl_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(web.SslStateSniEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.SslStateIPBasedEnabled),
+					string(web.SslStateSniEnabled),
+				}, false),
+			},
+
+			"tags": tags.Schema(),
+
+			"certificate_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppServiceManagedHostnameBindingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	appServiceId, err := parse.AppServiceID(d.Get("app_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHostnameBindingID(appServiceId.SubscriptionId, appServiceId.ResourceGroup, appServiceId.SiteName, d.Get("hostname").(string))
+
+	locks.ByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+
+	existing, err := client.GetHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_app_service_managed_hostname_binding", id.ID())
+	}
+
+	certificateId, certificate, err := appServiceManagedHostnameBindingCertificate(ctx, meta, id)
+	if err != nil {
+		return err
+	}
+
+	// the hostname must be bound to the App Service before a Managed Certificate can be issued for it, and the
+	// certificate must have been issued before SSL can be enabled on the binding - so this happens in three steps
+	binding := web.HostNameBinding{
+		HostNameBindingProperties: &web.HostNameBindingProperties{
+			SiteName: utils.String(id.SiteName),
+		},
+	}
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name, binding); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	certificate.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	thumbprint, err := createAppServiceManagedHostnameBindingCertificate(ctx, certClient, *certificateId, certificate, d.Timeout(pluginsdk.TimeoutCreate))
+	if err != nil {
+		// remove the binding we've just created, so that a failed certificate issuance doesn't leave a dangling binding
+		if _, deleteErr := client.DeleteHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name); deleteErr != nil {
+			log.Printf("[DEBUG] removing %s after the Managed Certificate couldn't be issued: %+v", id, deleteErr)
+		}
+		return err
+	}
+
+	binding.HostNameBindingProperties.SslState = web.SslState(d.Get("ssl_state").(string))
+	binding.HostNameBindingProperties.Thumbprint = thumbprint
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name, binding); err != nil {
+		return fmt.Errorf("enabling SSL for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAppServiceManagedHostnameBindingRead(d, meta)
+}
+
+func resourceAppServiceManagedHostnameBindingRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HostnameBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("hostname", id.Name)
+	d.Set("app_service_id", parse.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+
+	if props := resp.HostNameBindingProperties; props != nil {
+		d.Set("ssl_state", string(props.SslState))
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
+	certificateId, _, err := appServiceManagedHostnameBindingCertificate(ctx, meta, *id)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := certClient.Get(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certificate.Response) {
+			return fmt.Errorf("retrieving %s: %+v", *certificateId, err)
+		}
+	}
+
+	certificateIdRaw := ""
+	expirationDate := ""
+	if !utils.ResponseWasNotFound(certificate.Response) {
+		certificateIdRaw = certificateId.ID()
+		if props := certificate.CertificateProperties; props != nil && props.ExpirationDate != nil {
+			expirationDate = props.ExpirationDate.Format(time.RFC3339)
+		}
+	}
+	d.Set("certificate_id", certificateIdRaw)
+	d.Set("expiration_date", expirationDate)
+
+	return tags.FlattenAndSet(d, certificate.Tags)
+}
+
+func resourceAppServiceManagedHostnameBindingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HostnameBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		certificateId, certificate, err := appServiceManagedHostnameBindingCertificate(ctx, meta, *id)
+		if err != nil {
+			return err
+		}
+
+		// the Certificate PATCH model doesn't support tags, so the certificate is re-submitted instead
+		certificate.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		if _, err := createAppServiceManagedHostnameBindingCertificate(ctx, certClient, *certificateId, certificate, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAppServiceManagedHostnameBindingRead(d, meta)
+}
+
+func resourceAppServiceManagedHostnameBindingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HostnameBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	certificateId, _, err := appServiceManagedHostnameBindingCertificate(ctx, meta, *id)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+
+	// the certificate can't be deleted whilst it's in use, so the binding has to be removed first
+	resp, err := client.DeleteHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	certResp, err := certClient.Delete(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certResp) {
+			return fmt.Errorf("deleting %s: %+v", *certificateId, err)
+		}
+	}
+
+	return nil
+}
+
+// appServiceManagedHostnameBindingCertificate returns the ID of the Managed Certificate for the hostname, which lives
+// alongside the App Service Plan, together with the skeleton of the certificate used to request it.
+func appServiceManagedHostnameBindingCertificate(ctx context.Context, meta interface{}, id parse.HostnameBindingId) (*parse.ManagedCertificateId, web.Certificate, error) {
+	client := meta.(*clients.Client).Web.AppServicesClient
+
+	appService, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return nil, web.Certificate{}, fmt.Errorf("retrieving App Service %q (Resource Group %q): %+v", id.SiteName, id.ResourceGroup, err)
+	}
+
+	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
+		return nil, web.Certificate{}, fmt.Errorf("retrieving App Service %q (Resource Group %q): `serverFarmId` was nil", id.SiteName, id.ResourceGroup)
+	}
+
+	appServicePlanId, err := parse.AppServicePlanID(*appService.SiteProperties.ServerFarmID)
+	if err != nil {
+		return nil, web.Certificate{}, err
+	}
+
+	certificateId := parse.NewManagedCertificateID(appServicePlanId.SubscriptionId, appServicePlanId.ResourceGroup, id.Name)
+	certificate := web.Certificate{
+		CertificateProperties: &web.CertificateProperties{
+			CanonicalName: utils.String(id.Name),
+			ServerFarmID:  utils.String(appServicePlanId.ID()),
+			Password:      new(string),
+		},
+		Location: utils.String(location.NormalizeNilable(appService.Location)),
+	}
+
+	return &certificateId, certificate, nil
+}
+
+func createAppServiceManagedHostnameBindingCertificate(ctx context.Context, client *web.CertificatesClient, id parse.ManagedCertificateId, certificate web.Certificate, timeout time.Duration) (*string, error) {
+	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
+		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
+		if !utils.ResponseWasStatusCode(resp.Response, 202) {
+			return nil, fmt.Errorf("creating %s: %+v", id, err)
+		}
+	}
+
+	certificateWait := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound", "Unknown"},
+		Target:     []string{"Success"},
+		MinTimeout: 1 * time.Minute,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.CertificateName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return "NotFound", "NotFound", nil
+				}
+				return "Unknown", "Unknown", err
+			}
+			if utils.ResponseWasStatusCode(resp.Response, 200) && resp.CertificateProperties != nil && resp.CertificateProperties.Thumbprint != nil {
+				return resp, "Success", nil
+			}
+			return resp, "Unknown", nil
+		},
+	}
+
+	result, err := certificateWait.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for %s to be issued: %+v", id, err)
+	}
+
+	return result.(web.Certificate).CertificateProperties.Thumbprint, nil
+}
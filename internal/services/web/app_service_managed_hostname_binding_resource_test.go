@@ -0,0 +1,186 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppServiceManagedHostnameBindingResource struct{}
+
+func TestAccAppServiceManagedHostnameBinding_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_hostname_binding", "test")
+	r := AppServiceManagedHostnameBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ssl_state").HasValue("SniEnabled"),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("certificate_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppServiceManagedHostnameBinding_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_hostname_binding", "test")
+	r := AppServiceManagedHostnameBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t AppServiceManagedHostnameBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HostnameBindingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Web.AppServicesClient.GetHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.HostNameBindingProperties != nil && resp.HostNameBindingProperties.Thumbprint != nil), nil
+}
+
+func (t AppServiceManagedHostnameBindingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_hostname_binding" "test" {
+  hostname       = join(".", [azurerm_dns_cname_record.test.name, azurerm_dns_cname_record.test.zone_name])
+  app_service_id = azurerm_app_service.test.id
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+`, t.template(data))
+}
+
+func (t AppServiceManagedHostnameBindingResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_hostname_binding" "test" {
+  hostname       = join(".", [azurerm_dns_cname_record.test.name, azurerm_dns_cname_record.test.zone_name])
+  app_service_id = azurerm_app_service.test.id
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+`, t.template(data))
+}
+
+func (t AppServiceManagedHostnameBindingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_hostname_binding" "import" {
+  hostname       = azurerm_app_service_managed_hostname_binding.test.hostname
+  app_service_id = azurerm_app_service_managed_hostname_binding.test.app_service_id
+}
+`, t.basic(data))
+}
+
+func (AppServiceManagedHostnameBindingResource) template(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-asmhb-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "Linux"
+
+  sku {
+    tier = "Basic"
+    size = "B1"
+  }
+
+  reserved = true
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctest%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[4]s"
+  resource_group_name = "%[5]s"
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "%[3]s"
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 300
+  record              = azurerm_app_service.test.default_site_hostname
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = join(".", ["asuid", "%[3]s"])
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 300
+
+  record {
+    value = azurerm_app_service.test.custom_domain_verification_id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, dnsZone, dataResourceGroup)
+}
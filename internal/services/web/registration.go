@@ -43,6 +43,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_app_service_environment":                           resourceAppServiceEnvironment(),
 		"azurerm_app_service_hybrid_connection":                     resourceAppServiceHybridConnection(),
 		"azurerm_app_service_managed_certificate":                   resourceAppServiceManagedCertificate(),
+		"azurerm_app_service_managed_hostname_binding":              resourceAppServiceManagedHostnameBinding(),
 		"azurerm_app_service_plan":                                  resourceAppServicePlan(),
 		"azurerm_app_service_public_certificate":                    resourceAppServicePublicCertificate(),
 		"azurerm_app_service_slot":                                  resourceAppServiceSlot(),
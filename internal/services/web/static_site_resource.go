@@ -70,6 +70,14 @@ func resourceStaticSite() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"default_host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -126,6 +134,16 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(id.ID())
 
+	if d.HasChange("app_settings") {
+		appSettings := web.StringDictionary{
+			Properties: utils.ExpandMapStringPtrString(d.Get("app_settings").(map[string]interface{})),
+		}
+
+		if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name, appSettings); err != nil {
+			return fmt.Errorf("updating app settings for %s: %+v", id, err)
+		}
+	}
+
 	return resourceStaticSiteRead(d, meta)
 }
 
@@ -186,6 +204,15 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("api_key", apiKey)
 
+	appSettingsResp, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing app settings for %s: %v", id, err)
+	}
+
+	if err := d.Set("app_settings", utils.FlattenMapStringPtrString(appSettingsResp.Properties)); err != nil {
+		return fmt.Errorf("setting `app_settings`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -61,6 +61,39 @@ func TestAccAzureStaticSite_basicUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureStaticSite_appSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
+	r := StaticSiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.appSettings(data, "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.%").HasValue("1"),
+				check.That(data.ResourceName).Key("app_settings.setting").HasValue("foo"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.appSettings(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.setting").HasValue("bar"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.%").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureStaticSite_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
 	r := StaticSiteResource{}
@@ -142,6 +175,33 @@ resource "azurerm_static_site" "test" {
 `, data.RandomInteger, data.Locations.Secondary, data.RandomInteger) // TODO - Put back to primary when support ticket is resolved
 }
 
+func (r StaticSiteResource) appSettings(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_static_site" "test" {
+  name                = "acctestSS-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  app_settings = {
+    setting = "%s"
+  }
+
+  tags = {
+    environment = "acceptance"
+  }
+}
+`, data.RandomInteger, data.Locations.Secondary, data.RandomInteger, value) // TODO - Put back to primary when support ticket is resolved
+}
+
 func (r StaticSiteResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`